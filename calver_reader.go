@@ -0,0 +1,190 @@
+package chglog
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+)
+
+// calverReader synthesizes `Tag` from time buckets or a deployment log for repositories without git-tag.
+// The name of a synthetic tag is the hash of its newest commit so that git ranges keep working,
+// while `DisplayName` and `Version` hold the date based version (e.g. `2018-W05`).
+type calverReader struct {
+	client        gitcmd.Client
+	separator     string
+	bucket        string
+	deploymentLog string
+	paths         []string
+}
+
+func newCalverReader(client gitcmd.Client, bucket string, deploymentLog string, paths []string) *calverReader {
+	return &calverReader{
+		client:        client,
+		separator:     "@@__CHGLOG__@@",
+		bucket:        bucket,
+		deploymentLog: deploymentLog,
+		paths:         paths,
+	}
+}
+
+func (r *calverReader) ReadAll() ([]*Tag, error) {
+	var (
+		tags []*Tag
+		err  error
+	)
+
+	if r.deploymentLog != "" {
+		tags, err = r.readDeploymentLog()
+	} else {
+		tags, err = r.readBuckets()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool {
+		return tags[i].Date.After(tags[j].Date)
+	})
+	assignPreviousAndNextTag(tags)
+
+	return tags, nil
+}
+
+func (r *calverReader) readBuckets() ([]*Tag, error) {
+	switch r.bucket {
+	case "daily", "weekly", "monthly":
+	default:
+		return nil, fmt.Errorf("\"%s\" is not a supported version bucket", r.bucket)
+	}
+
+	args := []string{
+		"HEAD",
+		"--format=%H" + r.separator + "%ct",
+	}
+
+	if len(r.paths) > 0 {
+		args = append(args, "--")
+		args = append(args, r.paths...)
+	}
+
+	out, err := r.client.Exec("log", args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get git-log: %w", err)
+	}
+
+	tags := []*Tag{}
+	buckets := map[string]bool{}
+
+	for _, line := range strings.Split(out, "\n") {
+		tokens := strings.Split(line, r.separator)
+		if len(tokens) != 2 {
+			continue
+		}
+
+		ts, err := strconv.Atoi(tokens[1])
+		if err != nil {
+			return nil, err
+		}
+
+		// The committer date follows the order of git-log, unlike the author date of rebased commits.
+		// UTC, so that the buckets do not depend on the timezone of the machine
+		date := time.Unix(int64(ts), 0).UTC()
+		name := r.bucketName(date)
+
+		// The newest commit of the bucket is its end
+		if buckets[name] {
+			continue
+		}
+		buckets[name] = true
+
+		tags = append(tags, &Tag{
+			Name:        tokens[0],
+			DisplayName: name,
+			Version:     name,
+			Subject:     name,
+			Date:        date,
+		})
+	}
+
+	return tags, nil
+}
+
+func (r *calverReader) bucketName(date time.Time) string {
+	switch r.bucket {
+	case "weekly":
+		year, week := date.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case "monthly":
+		return date.Format("2006-01")
+	default:
+		return date.Format("2006-01-02")
+	}
+}
+
+// readDeploymentLog reads lines formatted as `<revision> <date> [<name>]`.
+// The date is either RFC 3339 or `2006-01-02`, it is also used as the name if omitted.
+func (r *calverReader) readDeploymentLog() ([]*Tag, error) {
+	file, err := os.Open(filepath.Clean(r.deploymentLog))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	tags := []*Tag{}
+	scanner := bufio.NewScanner(file)
+
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("%s:%d: expected \"<revision> <date> [<name>]\"", r.deploymentLog, n)
+		}
+
+		date, err := r.parseDate(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", r.deploymentLog, n, err)
+		}
+
+		hash, err := r.client.Exec("rev-parse", "--verify", fields[0]+"^{commit}")
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: \"%s\" is not a valid commit", r.deploymentLog, n, fields[0])
+		}
+
+		name := fields[1]
+		if len(fields) > 2 {
+			name = strings.Join(fields[2:], " ")
+		}
+
+		tags = append(tags, &Tag{
+			Name:        strings.TrimSpace(hash),
+			DisplayName: name,
+			Version:     name,
+			Subject:     name,
+			Date:        date,
+		})
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (*calverReader) parseDate(input string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, input); err == nil {
+		return t, nil
+	}
+	return time.ParseInLocation("2006-01-02", input, time.UTC)
+}
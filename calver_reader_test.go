@@ -0,0 +1,162 @@
+package chglog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+)
+
+func TestCalverReaderBuckets(t *testing.T) {
+	assert := assert.New(t)
+	client := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			if subcmd != "log" {
+				return "", errors.New("")
+			}
+			return strings.Join([]string{
+				"f6f6f6@@__CHGLOG__@@1517832000", // 2018-02-05 (W06)
+				"e5e5e5@@__CHGLOG__@@1517572800", // 2018-02-02 (W05)
+				"d4d4d4@@__CHGLOG__@@1517313600", // 2018-01-30 (W05)
+				"c3c3c3@@__CHGLOG__@@1516881600", // 2018-01-25 (W04)
+				"",
+			}, "\n"), nil
+		},
+	}
+
+	tags, err := newCalverReader(client, "weekly", "", nil).ReadAll()
+	assert.Nil(err)
+	assert.Len(tags, 3)
+
+	assert.Equal("f6f6f6", tags[0].Name)
+	assert.Equal("2018-W06", tags[0].DisplayName)
+	assert.Equal("2018-W06", tags[0].Version)
+	assert.Equal(time.Unix(1517832000, 0).UTC(), tags[0].Date)
+	assert.Equal("e5e5e5", tags[0].Previous.Name)
+
+	assert.Equal("e5e5e5", tags[1].Name)
+	assert.Equal("2018-W05", tags[1].Version)
+	assert.Equal("c3c3c3", tags[1].Previous.Name)
+
+	assert.Equal("c3c3c3", tags[2].Name)
+	assert.Equal("2018-W04", tags[2].Version)
+	assert.Nil(tags[2].Previous)
+
+	tags, err = newCalverReader(client, "monthly", "", nil).ReadAll()
+	assert.Nil(err)
+	assert.Len(tags, 2)
+	assert.Equal("2018-02", tags[0].Version)
+	assert.Equal("2018-01", tags[1].Version)
+	assert.Equal("d4d4d4", tags[1].Name)
+
+	tags, err = newCalverReader(client, "daily", "", nil).ReadAll()
+	assert.Nil(err)
+	assert.Len(tags, 4)
+	assert.Equal("2018-01-30", tags[2].Version)
+
+	_, err = newCalverReader(client, "yearly", "", nil).ReadAll()
+	assert.Error(err)
+}
+
+func TestCalverReaderBucketsInUTC(t *testing.T) {
+	assert := assert.New(t)
+
+	local := time.Local
+	time.Local = time.FixedZone("UTC+9", 9*60*60)
+	defer func() {
+		time.Local = local
+	}()
+
+	client := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			return "a1a1a1@@__CHGLOG__@@1517441400\n", nil // 2018-01-31 23:30 UTC, 2018-02-01 in UTC+9
+		},
+	}
+
+	tags, err := newCalverReader(client, "monthly", "", nil).ReadAll()
+	assert.Nil(err)
+	assert.Len(tags, 1)
+	assert.Equal("2018-01", tags[0].Version)
+}
+
+func TestCalverReaderBucketsByCommitterDate(t *testing.T) {
+	assert := assert.New(t)
+
+	var (
+		tags        []*Tag
+		first, last string
+		err         error
+	)
+
+	setup("calver_rebased", func(_ commitFunc, _ tagFunc, git gitcmd.Client) {
+		commit := func(authorDate, committerDate, subject string) {
+			_ = os.Setenv("GIT_COMMITTER_DATE", committerDate)
+			_, _ = git.Exec("commit", "--allow-empty", "--date", authorDate, "-m", subject)
+		}
+		defer os.Unsetenv("GIT_COMMITTER_DATE")
+
+		commit("2018-01-01T00:00:00Z", "2018-01-01T00:00:00Z", "feat: First week")
+		first, _ = git.Exec("rev-parse", "HEAD")
+		commit("2018-01-09T00:00:00Z", "2018-01-09T00:00:00Z", "feat: Second week")
+		// authored in the first week, rebased in the second one
+		commit("2018-01-02T00:00:00Z", "2018-01-10T00:00:00Z", "fix: Rebased")
+		last, _ = git.Exec("rev-parse", "HEAD")
+
+		tags, err = newCalverReader(git, "weekly", "", nil).ReadAll()
+	})
+
+	assert.Nil(err)
+	assert.Len(tags, 2)
+	assert.Equal("2018-W02", tags[0].Version)
+	assert.Equal(strings.TrimSpace(last), tags[0].Name)
+	assert.Equal("2018-W01", tags[1].Version)
+	assert.Equal(strings.TrimSpace(first), tags[1].Name)
+}
+
+func TestCalverReaderDeploymentLog(t *testing.T) {
+	assert := assert.New(t)
+	client := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			if subcmd != "rev-parse" || args[len(args)-1] == "unknown^{commit}" {
+				return "", errors.New("")
+			}
+			return strings.TrimSuffix(args[len(args)-1], "^{commit}") + "full\n", nil
+		},
+	}
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "deployments.log")
+
+	_ = os.WriteFile(file, []byte(`# deployments
+a1a1a1 2018-01-25
+c3c3c3 2018-02-05T10:00:00Z Release 42
+
+b2b2b2 2018-02-01T00:00:00+09:00
+`), os.ModePerm)
+
+	tags, err := newCalverReader(client, "", file, nil).ReadAll()
+	assert.Nil(err)
+	assert.Len(tags, 3)
+
+	assert.Equal("c3c3c3full", tags[0].Name)
+	assert.Equal("Release 42", tags[0].DisplayName)
+	assert.Equal(time.Date(2018, 2, 5, 10, 0, 0, 0, time.UTC), tags[0].Date.UTC())
+
+	assert.Equal("b2b2b2full", tags[1].Name)
+	assert.Equal("2018-02-01T00:00:00+09:00", tags[1].Version)
+
+	assert.Equal("a1a1a1full", tags[2].Name)
+	assert.Equal("2018-01-25", tags[2].Version)
+	assert.Equal("b2b2b2full", tags[2].Next.Name)
+
+	// invalid revision
+	_ = os.WriteFile(file, []byte("unknown 2018-01-25\n"), os.ModePerm)
+	_, err = newCalverReader(client, "", file, nil).ReadAll()
+	assert.Error(err)
+	assert.Contains(err.Error(), "deployments.log:1")
+}
@@ -18,10 +18,17 @@ import (
 
 // Options is an option used to process commits
 type Options struct {
-	Processor                   Processor
+	Processor                   Processor           // Deprecated: Use `Processors`. It is applied before `Processors`
+	Processors                  []Processor         // Processors applied to each commit in order
 	NextTag                     string              // Treat unreleased commits as specified tags (EXPERIMENTAL)
+	NextTagRef                  string              // Commit at which `NextTag` is cut, later commits remain unreleased. Only if `NextTag` is specified
+	FetchTags                   bool                // Fetch tags from the remote before reading them (e.g. for CI clones without tags)
+	Unshallow                   bool                // Convert a shallow clone into a complete one before reading tags
 	TagFilterPattern            string              // Filter tag by regexp
+	TagNamePattern              string              // A regular expression whose named groups `DisplayName` and `Version` are assigned to `Tag`
 	Sort                        string              // Specify how to sort tags; currently supports "date" (default) or by "semver".
+	CalVerBucket                string              // Synthesize versions from time buckets instead of git-tag; currently supports "daily", "weekly" or "monthly"
+	CalVerDeploymentLog         string              // Synthesize versions from a deployment log (`<revision> <date> [<name>]` per line) instead of git-tag
 	NoCaseSensitive             bool                // Filter commits in a case insensitive way
 	CommitFilters               map[string][]string // Filter by using `Commit` properties and values. Filtering is not done by specifying an empty value
 	CommitSortBy                string              // Property name to use for sorting `Commit` (e.g. `Scope`)
@@ -29,9 +36,15 @@ type Options struct {
 	CommitGroupSortBy           string              // Property name to use for sorting `CommitGroup` (e.g. `Title`)
 	CommitGroupTitleOrder       []string            // Predefined sorted list of titles to use for sorting `CommitGroup`. Only if `CommitGroupSortBy` is `Custom`
 	CommitGroupTitleMaps        map[string]string   // Map for `CommitGroup` title conversion
+	VersionGroupBy              string              // Series used to group `Version` into `VersionGroup`; currently supports "major" or "minor". Grouping is not done by specifying an empty value
 	HeaderPattern               string              // A regular expression to use for parsing the commit header
 	HeaderPatternMaps           []string            // A rule for mapping the result of `HeaderPattern` to the property of `Commit`
 	IssuePrefix                 []string            // Prefix used for issues (e.g. `#`, `gh-`)
+	PullRequestPrefix           []string            // Prefix used for pull requests or merge requests (e.g. `!`)
+	Aliases                     map[string]string   // Map of emails to usernames on the forge, used for `Username` of authors and contacts
+	Contributors                bool                // Fill `Version.Contributors` and `NewContributors`, the whole history is read once for the latter
+	IssueTracker                IssueTracker        // Fetches the issues of `Ref` (e.g. GitHub Issues, Jira), `nil` disables it
+	IssueKeyPattern             string              // A regular expression of the project keys of `IssueTracker` (e.g. `ENG|OPS` of Linear or YouTrack) to find refs such as `Closes ENG-123`
 	RefActions                  []string            // Word list of `Ref.Action`
 	MergePattern                string              // A regular expression to use for parsing the merge commit
 	MergePatternMaps            []string            // Similar to `HeaderPatternMaps`
@@ -41,9 +54,24 @@ type Options struct {
 	JiraUsername                string
 	JiraToken                   string
 	JiraURL                     string
-	JiraTypeMaps                map[string]string
+	JiraExport                  string            // JSON or CSV export of Jira issues, or a directory of issue JSON files, read instead of requesting Jira
+	JiraAuthType                string            // `JiraAuthBasic` (default), `JiraAuthBearer` or `JiraAuthOAuth1`. The token is the password, the personal access token or the OAuth access token
+	JiraOAuthConsumerKey        string            // Consumer key of the application link. Only for `JiraAuthOAuth1`
+	JiraOAuthPrivateKeyFile     string            // PEM file of the RSA private key of the application link. Only for `JiraAuthOAuth1`
+	JiraTimeout                 time.Duration     // Timeout of a request to Jira and longest `Retry-After` wait, 30 seconds if zero
+	JiraMaxRetries              int               // Number of retries on 429 and 5xx responses of Jira, 3 if zero and none if negative
+	JiraIssueKeyPattern         string            // A regular expression of Jira project keys (e.g. `PROJ|OPS`) to find issue keys and refs in the header and body, only `JiraIssueID` is used if empty
+	JiraTypeMaps                map[string]string // Map of Jira issue types to commit types (e.g. `Story: feat`)
+	JiraLabelTypeMaps           map[string]string // Map of Jira labels to commit types (e.g. `security: security`), preferred to `JiraTypeMaps`
+	JiraTypePrecedence          string            // `JiraTypePrecedenceJira` (default) or `JiraTypePrecedenceCommit`, the type kept when both the commit and the issue have one
+	JiraTypeDefault             string            // Type of the commits with a Jira issue but neither a type nor a mapped Jira type
+	JiraFixVersions             bool              // Synthesize versions from the Jira fix versions of the issues referenced by commits instead of git-tag
+	JiraFields                  map[string]string // Map of names to Jira field IDs extracted into `JiraIssue.Fields` (e.g. `Epic: customfield_10014`, `FixVersions: fixVersions`)
 	JiraIssueDescriptionPattern string
 	Paths                       []string // Path filter
+	LintTypes                   []string // Types allowed by `Linter`, those of the `Type` filter and of `CommitGroupTitleMaps` if empty
+	LintScopes                  []string // Scopes allowed by `Linter`, any scope if empty
+	Strict                      bool     // Fail with a `*StrictError` instead of rendering if an enrichment fails, a header matches no pattern or a tag of the query does not exist
 }
 
 // Info is metadata related to CHANGELOG
@@ -54,9 +82,10 @@ type Info struct {
 
 // RenderData is the data passed to the template
 type RenderData struct {
-	Info       *Info
-	Unreleased *Unreleased
-	Versions   []*Version
+	Info          *Info
+	Unreleased    *Unreleased
+	Versions      []*Version
+	VersionGroups []*VersionGroup // Only if `VersionGroupBy` is specified
 }
 
 // Config for generating CHANGELOG
@@ -97,12 +126,19 @@ func normalizeConfig(config *Config) {
 
 // Generator of CHANGELOG
 type Generator struct {
-	client          gitcmd.Client
-	config          *Config
-	tagReader       *tagReader
-	tagSelector     *tagSelector
-	commitParser    *commitParser
-	commitExtractor *commitExtractor
+	client            gitcmd.Client
+	config            *Config
+	logger            *Logger
+	shallowChecker    *shallowChecker
+	contributorReader *contributorReader
+	tagReader         *tagReader
+	calverReader      *calverReader
+	jiraVersionReader *jiraVersionReader
+	tagSelector       *tagSelector
+	commitParser      *commitParser
+	commitExtractor   *commitExtractor
+	versionGrouper    *versionGrouper
+	problems          []string // reported in strict mode with those of the commit parser
 }
 
 // NewGenerator receives `Config` and create an new `Generator`
@@ -113,19 +149,25 @@ func NewGenerator(logger *Logger, config *Config) *Generator {
 
 	jiraClient := NewJiraClient(config)
 
-	if config.Options.Processor != nil {
-		config.Options.Processor.Bootstrap(config)
+	for _, processor := range config.Options.processors() {
+		processor.Bootstrap(config)
 	}
 
 	normalizeConfig(config)
 
 	return &Generator{
-		client:          client,
-		config:          config,
-		tagReader:       newTagReader(client, config.Options.TagFilterPattern, config.Options.Sort),
-		tagSelector:     newTagSelector(),
-		commitParser:    newCommitParser(logger, client, jiraClient, config),
-		commitExtractor: newCommitExtractor(config.Options),
+		client:            client,
+		config:            config,
+		logger:            logger,
+		shallowChecker:    newShallowChecker(client),
+		contributorReader: newContributorReader(client, config.Options.Aliases),
+		tagReader:         newTagReader(client, config.Options.TagFilterPattern, config.Options.TagNamePattern, config.Options.Sort),
+		calverReader:      newCalverReader(client, config.Options.CalVerBucket, config.Options.CalVerDeploymentLog, config.Options.Paths),
+		jiraVersionReader: newJiraVersionReader(),
+		tagSelector:       newTagSelector(),
+		commitParser:      newCommitParser(logger, client, jiraClient, config),
+		commitExtractor:   newCommitExtractor(config.Options),
+		versionGrouper:    newVersionGrouper(config.Options.VersionGroupBy),
 	}
 }
 
@@ -138,6 +180,9 @@ func NewGenerator(logger *Logger, config *Config) *Generator {
 //	..<tagname>  - Commit from the oldest tag to `<tagname>` (e.g. `..1.0.0`)
 //	<tagname>    - Commit contained in `<tagname>` (e.g. `1.0.0`)
 func (gen *Generator) Generate(w io.Writer, query string) error {
+	gen.problems = nil
+	gen.commitParser.reset()
+
 	back, err := gen.workdir()
 	if err != nil {
 		return err
@@ -148,11 +193,23 @@ func (gen *Generator) Generate(w io.Writer, query string) error {
 		}
 	}()
 
-	tags, first, err := gen.getTags(query)
+	shallow, err := gen.prepareRepository()
 	if err != nil {
 		return err
 	}
 
+	if gen.config.Options.JiraFixVersions {
+		return gen.generateFromJiraVersions(w, query)
+	}
+
+	tags, first, err := gen.getTags(query)
+	if err != nil {
+		if shallow && errors.Is(err, errNotFoundTags) {
+			return fmt.Errorf("%w: the repository is a shallow clone, try `--fetch-tags` or `--unshallow`", err)
+		}
+		return gen.orStrict(err)
+	}
+
 	unreleased, err := gen.readUnreleased(tags)
 	if err != nil {
 		return err
@@ -164,12 +221,86 @@ func (gen *Generator) Generate(w io.Writer, query string) error {
 	}
 
 	if len(versions) == 0 {
-		return fmt.Errorf("commits corresponding to \"%s\" was not found", query)
+		return gen.orStrict(fmt.Errorf("commits corresponding to \"%s\" was not found", query))
+	}
+
+	if err = gen.checkStrict(); err != nil {
+		return err
 	}
 
 	return gen.render(w, unreleased, versions)
 }
 
+// generateFromJiraVersions renders the versions built from the Jira fix versions of the commits in the history
+func (gen *Generator) generateFromJiraVersions(w io.Writer, query string) error {
+	commits, err := gen.commitParser.Parse("HEAD")
+	if err != nil {
+		return err
+	}
+
+	tags, assigned, unreleased := gen.jiraVersionReader.Read(commits)
+	if len(tags) == 0 {
+		return errors.New("no Jira fix version was found in the issues referenced by the commits")
+	}
+
+	if query != "" {
+		tags, _, err = gen.selectTags(tags, query)
+		if err != nil {
+			return gen.orStrict(err)
+		}
+	}
+
+	versions := []*Version{}
+	for _, tag := range tags {
+		version, err := gen.newVersion(tag, assigned[tag.Name])
+		if err != nil {
+			return err
+		}
+		versions = append(versions, version)
+	}
+
+	if len(versions) == 0 {
+		return gen.orStrict(fmt.Errorf("commits corresponding to \"%s\" was not found", query))
+	}
+
+	if err = gen.checkStrict(); err != nil {
+		return err
+	}
+
+	return gen.render(w, gen.newUnreleased(unreleased), versions)
+}
+
+// checkStrict returns the problems found so far as a `*StrictError` in strict mode
+func (gen *Generator) checkStrict() error {
+	if !gen.config.Options.Strict {
+		return nil
+	}
+
+	problems := append(append([]string{}, gen.problems...), gen.commitParser.problems...)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	return &StrictError{Problems: problems}
+}
+
+// orStrict returns the problems found so far instead of err in strict mode, e.g. the missing tag of a query
+func (gen *Generator) orStrict(err error) error {
+	if strictErr := gen.checkStrict(); strictErr != nil {
+		return strictErr
+	}
+	return err
+}
+
+// selectTags selects the tags of the query, the tags of the query that do not exist are kept for the strict mode
+func (gen *Generator) selectTags(tags []*Tag, query string) ([]*Tag, string, error) {
+	for _, token := range gen.tagSelector.Unmatched(tags, query) {
+		gen.problems = append(gen.problems, fmt.Sprintf("tag \"%s\" of the query \"%s\" does not exist", token, query))
+	}
+
+	return gen.tagSelector.Select(tags, query)
+}
+
 func (gen *Generator) readVersions(tags []*Tag, first string) ([]*Version, error) {
 	next := gen.config.Options.NextTag
 	versions := []*Version{}
@@ -181,10 +312,15 @@ func (gen *Generator) readVersions(tags []*Tag, first string) ([]*Version, error
 		)
 
 		if isNext {
+			head := "HEAD"
+			if gen.config.Options.NextTagRef != "" {
+				head = gen.config.Options.NextTagRef
+			}
+
 			if tag.Previous != nil {
-				rev = tag.Previous.Name + "..HEAD"
+				rev = tag.Previous.Name + ".." + head
 			} else {
-				rev = "HEAD"
+				rev = head
 			}
 		} else {
 			if i+1 < len(tags) {
@@ -203,34 +339,78 @@ func (gen *Generator) readVersions(tags []*Tag, first string) ([]*Version, error
 			return nil, err
 		}
 
-		commitGroups, mergeCommits, revertCommits, noteGroups := gen.commitExtractor.Extract(commits)
-
-		versions = append(versions, &Version{
-			Tag:           tag,
-			CommitGroups:  commitGroups,
-			Commits:       commits,
-			MergeCommits:  mergeCommits,
-			RevertCommits: revertCommits,
-			NoteGroups:    noteGroups,
-		})
+		if gen.shallowChecker.IsTruncated(commits) {
+			gen.logger.Warn(fmt.Sprintf("\"%s\" may be incomplete: commits of \"%s\" are beyond the shallow clone history", tag.Name, rev))
+		}
 
 		// Instead of `getTags()`, assign the date to the tag
 		if isNext && len(commits) != 0 {
 			tag.Date = commits[0].Author.Date
 		}
+
+		version, err := gen.newVersion(tag, commits)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, version)
 	}
 
 	return versions, nil
 }
 
-func (gen *Generator) readUnreleased(tags []*Tag) (*Unreleased, error) {
-	if gen.config.Options.NextTag != "" {
-		return &Unreleased{}, nil
+func (gen *Generator) newVersion(tag *Tag, commits []*Commit) (*Version, error) {
+	commitGroups, mergeCommits, revertCommits, noteGroups := gen.commitExtractor.Extract(commits)
+
+	version := &Version{
+		Tag:           tag,
+		CommitGroups:  commitGroups,
+		Commits:       commits,
+		MergeCommits:  mergeCommits,
+		RevertCommits: revertCommits,
+		NoteGroups:    noteGroups,
+		JiraIssues:    uniqJiraIssues(commits),
 	}
 
+	if gen.config.Options.Contributors {
+		var err error
+		version.Contributors, version.NewContributors, err = gen.contributorReader.Read(commits)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	gen.processVersion(version)
+
+	return version, nil
+}
+
+func (gen *Generator) processVersion(version *Version) {
+	for _, processor := range gen.config.Options.processors() {
+		if p, ok := processor.(VersionProcessor); ok {
+			p.ProcessVersion(version)
+		}
+	}
+}
+
+func (gen *Generator) processRenderData(data *RenderData) {
+	for _, processor := range gen.config.Options.processors() {
+		if p, ok := processor.(RenderDataProcessor); ok {
+			p.ProcessRenderData(data)
+		}
+	}
+}
+
+func (gen *Generator) readUnreleased(tags []*Tag) (*Unreleased, error) {
+	opts := gen.config.Options
 	rev := "HEAD"
 
-	if len(tags) > 0 {
+	switch {
+	case opts.NextTag != "" && opts.NextTagRef != "":
+		rev = opts.NextTagRef + "..HEAD"
+	case opts.NextTag != "":
+		return &Unreleased{}, nil
+	case len(tags) > 0:
 		rev = tags[0].Name + "..HEAD"
 	}
 
@@ -239,59 +419,80 @@ func (gen *Generator) readUnreleased(tags []*Tag) (*Unreleased, error) {
 		return nil, err
 	}
 
+	if gen.shallowChecker.IsTruncated(commits) {
+		gen.logger.Warn(fmt.Sprintf("Unreleased may be incomplete: commits of \"%s\" are beyond the shallow clone history", rev))
+	}
+
+	return gen.newUnreleased(commits), nil
+}
+
+func (gen *Generator) newUnreleased(commits []*Commit) *Unreleased {
 	commitGroups, mergeCommits, revertCommits, noteGroups := gen.commitExtractor.Extract(commits)
 
-	unreleased := &Unreleased{
+	return &Unreleased{
 		CommitGroups:  commitGroups,
 		Commits:       commits,
 		MergeCommits:  mergeCommits,
 		RevertCommits: revertCommits,
 		NoteGroups:    noteGroups,
+		JiraIssues:    uniqJiraIssues(commits),
 	}
-
-	return unreleased, nil
 }
 
-func (gen *Generator) getTags(query string) ([]*Tag, string, error) {
-	tags, err := gen.tagReader.ReadAll()
+// prepareRepository fetches tags or history as requested by the options,
+// and reports whether the repository is (still) a shallow clone
+func (gen *Generator) prepareRepository() (bool, error) {
+	opts := gen.config.Options
+
+	shallow, err := gen.shallowChecker.IsShallow()
 	if err != nil {
-		return nil, "", err
+		return false, err
 	}
 
-	next := gen.config.Options.NextTag
-	if next != "" {
-		for _, tag := range tags {
-			if next == tag.Name {
-				return nil, "", fmt.Errorf("\"%s\" tag already exists", next)
-			}
+	switch {
+	case shallow && opts.Unshallow:
+		if err = gen.shallowChecker.Unshallow(); err != nil {
+			return false, fmt.Errorf("failed to unshallow the repository: %w", err)
+		}
+		shallow = false
+	case opts.FetchTags:
+		if err = gen.shallowChecker.FetchTags(); err != nil {
+			return false, fmt.Errorf("failed to fetch tags: %w", err)
 		}
+	}
 
-		var previous *RelateTag
-		if len(tags) > 0 {
-			previous = &RelateTag{
-				Name:    tags[0].Name,
-				Subject: tags[0].Subject,
-				Date:    tags[0].Date,
-			}
+	if shallow {
+		if err = gen.shallowChecker.Load(); err != nil {
+			return false, err
 		}
+		gen.logger.Warn("The repository is a shallow clone, the CHANGELOG may be incomplete. Use `--unshallow` to fetch the complete history")
+	}
+
+	return shallow, nil
+}
+
+func (gen *Generator) getTags(query string) ([]*Tag, string, error) {
+	tags, err := gen.readTags()
+	if err != nil {
+		return nil, "", err
+	}
 
-		// Assign the date with `readVersions()`
-		tags = append([]*Tag{
-			{
-				Name:     next,
-				Subject:  next,
-				Previous: previous,
-			},
-		}, tags...)
+	if gen.config.Options.NextTag != "" {
+		tags, err = gen.prependNextTag(tags)
+		if err != nil {
+			return nil, "", err
+		}
+	} else if gen.config.Options.NextTagRef != "" {
+		return nil, "", errors.New("the next tag ref can only be used with the next tag")
 	}
 
 	if len(tags) == 0 {
-		return nil, "", errors.New("git-tag does not exist")
+		return nil, "", errNotFoundTags
 	}
 
 	first := ""
 	if query != "" {
-		tags, first, err = gen.tagSelector.Select(tags, query)
+		tags, first, err = gen.selectTags(tags, query)
 		if err != nil {
 			return nil, "", err
 		}
@@ -300,13 +501,68 @@ func (gen *Generator) getTags(query string) ([]*Tag, string, error) {
 	return tags, first, nil
 }
 
+func (gen *Generator) readTags() ([]*Tag, error) {
+	opts := gen.config.Options
+
+	if opts.CalVerBucket != "" || opts.CalVerDeploymentLog != "" {
+		return gen.calverReader.ReadAll()
+	}
+
+	return gen.tagReader.ReadAll()
+}
+
+func (gen *Generator) prependNextTag(tags []*Tag) ([]*Tag, error) {
+	next := gen.config.Options.NextTag
+
+	if ref := gen.config.Options.NextTagRef; ref != "" {
+		if _, err := gen.client.Exec("rev-parse", "--verify", ref+"^{commit}"); err != nil {
+			return nil, fmt.Errorf("\"%s\" is not a valid commit for the next tag", ref)
+		}
+	}
+
+	for _, tag := range tags {
+		if next == tag.Name {
+			return nil, fmt.Errorf("\"%s\" tag already exists", next)
+		}
+	}
+
+	var previous *RelateTag
+	if len(tags) > 0 {
+		previous = &RelateTag{
+			Name:        tags[0].Name,
+			DisplayName: tags[0].DisplayName,
+			Version:     tags[0].Version,
+			Subject:     tags[0].Subject,
+			Date:        tags[0].Date,
+		}
+	}
+
+	displayName, version := gen.tagReader.parseName(next)
+
+	// Assign the date with `readVersions()`
+	return append([]*Tag{
+		{
+			Name:        next,
+			DisplayName: displayName,
+			Version:     version,
+			Subject:     next,
+			Previous:    previous,
+		},
+	}, tags...), nil
+}
+
 func (gen *Generator) workdir() (func() error, error) {
+	return changeDir(gen.config.WorkingDir)
+}
+
+// changeDir changes the working directory, the returned function goes back to the previous one
+func changeDir(dir string) (func() error, error) {
 	cwd, err := os.Getwd()
 	if err != nil {
 		return nil, err
 	}
 
-	err = os.Chdir(gen.config.WorkingDir)
+	err = os.Chdir(dir)
 	if err != nil {
 		return nil, err
 	}
@@ -354,9 +610,15 @@ func (gen *Generator) render(w io.Writer, unreleased *Unreleased, versions []*Ve
 
 	t := template.Must(template.New(fname).Funcs(sprig.TxtFuncMap()).Funcs(fmap).ParseFiles(gen.config.Template))
 
-	return t.Execute(w, &RenderData{
+	data := &RenderData{
 		Info:       gen.config.Info,
 		Unreleased: unreleased,
 		Versions:   versions,
-	})
+	}
+	gen.processRenderData(data)
+
+	// groups follow the versions left by the processors
+	data.VersionGroups = gen.versionGrouper.Group(data.Versions)
+
+	return t.Execute(w, data)
 }
@@ -2,6 +2,8 @@ package chglog
 
 import (
 	"bytes"
+	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -408,6 +410,90 @@ func TestGeneratorWithNextTag(t *testing.T) {
 [3.0.0]: https://github.com/git-chglog/git-chglog/compare/2.0.0...3.0.0`, expected)
 }
 
+func TestGeneratorWithNextTagRef(t *testing.T) {
+	assert := assert.New(t)
+	testName := "type_scope_subject"
+	ref := ""
+
+	setup(testName, func(commit commitFunc, tag tagFunc, git gitcmd.Client) {
+		commit("2018-01-01 00:00:00", "feat(core): version 1.0.0", "")
+		tag("1.0.0")
+
+		commit("2018-02-01 00:00:00", "feat(core): version 2.0.0", "")
+		ref, _ = git.Exec("rev-parse", "HEAD")
+
+		commit("2018-03-01 00:00:00", "feat(core): after release", "")
+	})
+
+	gen := NewGenerator(NewLogger(os.Stdout, os.Stderr, false, true),
+		&Config{
+			Bin:        "git",
+			WorkingDir: filepath.Join(testRepoRoot, testName),
+			Template:   filepath.Join(cwd, "testdata", testName+".md"),
+			Info: &Info{
+				Title:         "CHANGELOG Example",
+				RepositoryURL: "https://github.com/git-chglog/git-chglog",
+			},
+			Options: &Options{
+				Sort:       "date",
+				NextTag:    "2.0.0",
+				NextTagRef: strings.TrimSpace(ref),
+				CommitFilters: map[string][]string{
+					"Type": {
+						"feat",
+					},
+				},
+				CommitSortBy:      "Scope",
+				CommitGroupBy:     "Type",
+				CommitGroupSortBy: "Title",
+				CommitGroupTitleMaps: map[string]string{
+					"feat": "Features",
+				},
+				HeaderPattern: "^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$",
+				HeaderPatternMaps: []string{
+					"Type",
+					"Scope",
+					"Subject",
+				},
+			},
+		})
+
+	buf := &bytes.Buffer{}
+	err := gen.Generate(buf, "")
+	expected := strings.TrimSpace(buf.String())
+
+	assert.Nil(err)
+	assert.Equal(`<a name="unreleased"></a>
+## [Unreleased]
+
+### Features
+- **core:** after release
+
+
+<a name="2.0.0"></a>
+## [2.0.0] - 2018-02-01
+### Features
+- **core:** version 2.0.0
+
+
+<a name="1.0.0"></a>
+## 1.0.0 - 2018-01-01
+### Features
+- **core:** version 1.0.0
+
+
+[Unreleased]: https://github.com/git-chglog/git-chglog/compare/2.0.0...HEAD
+[2.0.0]: https://github.com/git-chglog/git-chglog/compare/1.0.0...2.0.0`, expected)
+
+	// invalid ref
+	gen.config.Options.NextTagRef = "unknown-ref"
+	buf = &bytes.Buffer{}
+	err = gen.Generate(buf, "")
+
+	assert.Error(err)
+	assert.Contains(err.Error(), "\"unknown-ref\" is not a valid commit")
+}
+
 func TestGeneratorWithTagFiler(t *testing.T) {
 	assert := assert.New(t)
 	testName := "type_scope_subject"
@@ -471,6 +557,101 @@ func TestGeneratorWithTagFiler(t *testing.T) {
 
 }
 
+type statsProcessor struct{}
+
+func (*statsProcessor) Bootstrap(*Config) {}
+
+func (*statsProcessor) ProcessCommit(commit *Commit) *Commit {
+	return commit
+}
+
+func (*statsProcessor) ProcessVersion(version *Version) {
+	version.CommitGroups = append([]*CommitGroup{
+		{Title: fmt.Sprintf("%d commits", len(version.Commits))},
+	}, version.CommitGroups...)
+}
+
+func (*statsProcessor) ProcessRenderData(data *RenderData) {
+	// oldest first
+	for i, j := 0, len(data.Versions)-1; i < j; i, j = i+1, j-1 {
+		data.Versions[i], data.Versions[j] = data.Versions[j], data.Versions[i]
+	}
+}
+
+func TestGeneratorWithVersionAndRenderDataProcessor(t *testing.T) {
+	assert := assert.New(t)
+	testName := "type_scope_subject"
+
+	setup(testName, func(commit commitFunc, tag tagFunc, _ gitcmd.Client) {
+		commit("2018-01-01 00:00:00", "feat(core): Initial commit", "")
+		commit("2018-01-02 00:00:00", "fix(core): Fix bug", "")
+		tag("1.0.0")
+
+		commit("2018-02-01 00:00:00", "feat(core): Add feature", "")
+		tag("1.1.0")
+	})
+
+	gen := NewGenerator(NewLogger(os.Stdout, os.Stderr, false, true),
+		&Config{
+			Bin:        "git",
+			WorkingDir: filepath.Join(testRepoRoot, testName),
+			Template:   filepath.Join(cwd, "testdata", testName+".md"),
+			Info: &Info{
+				Title:         "CHANGELOG Example",
+				RepositoryURL: "https://github.com/git-chglog/git-chglog",
+			},
+			Options: &Options{
+				Sort:       "date",
+				Processors: []Processor{&statsProcessor{}},
+				CommitFilters: map[string][]string{
+					"Type": {
+						"feat",
+					},
+				},
+				CommitSortBy:      "Scope",
+				CommitGroupBy:     "Type",
+				CommitGroupSortBy: "Title",
+				CommitGroupTitleMaps: map[string]string{
+					"feat": "Features",
+				},
+				HeaderPattern: "^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$",
+				HeaderPatternMaps: []string{
+					"Type",
+					"Scope",
+					"Subject",
+				},
+			},
+		})
+
+	buf := &bytes.Buffer{}
+	err := gen.Generate(buf, "")
+	expected := strings.TrimSpace(buf.String())
+
+	assert.Nil(err)
+	assert.Equal(`<a name="unreleased"></a>
+## [Unreleased]
+
+
+<a name="1.0.0"></a>
+## 1.0.0 - 2018-01-02
+### 2 commits
+
+### Features
+- **core:** Initial commit
+
+
+<a name="1.1.0"></a>
+## [1.1.0] - 2018-02-01
+### 1 commits
+
+### Features
+- **core:** Add feature
+
+
+[Unreleased]: https://github.com/git-chglog/git-chglog/compare/1.0.0...HEAD
+[1.1.0]: https://github.com/git-chglog/git-chglog/compare/1.0.0...1.1.0`, expected)
+}
+
 func TestGeneratorWithTrimmedBody(t *testing.T) {
 	assert := assert.New(t)
 	testName := "trimmed_body"
@@ -629,3 +810,317 @@ func TestGeneratorWithSprig(t *testing.T) {
 [2.0.0]: https://github.com/git-chglog/git-chglog/compare/1.0.0...2.0.0`, expected)
 
 }
+
+func TestGeneratorWithShallowClone(t *testing.T) {
+	assert := assert.New(t)
+	testName := "type_scope_subject"
+	cloneName := "shallow_clone"
+
+	setup(testName, func(commit commitFunc, tag tagFunc, _ gitcmd.Client) {
+		commit("2018-01-01 00:00:00", "feat(core): version 1.0.0", "")
+		tag("1.0.0")
+
+		commit("2018-02-01 00:00:00", "feat(core): version 2.0.0", "")
+		tag("2.0.0")
+	})
+
+	cloneDir := filepath.Join(cwd, testRepoRoot, cloneName)
+	_ = os.RemoveAll(cloneDir)
+	git := gitcmd.New(nil)
+	_, err := git.Exec("clone", "--depth", "1", "--no-tags", "file://"+filepath.ToSlash(filepath.Join(cwd, testRepoRoot, testName)), cloneDir)
+	assert.Nil(err)
+
+	newGenerator := func(unshallow bool) *Generator {
+		return NewGenerator(NewLogger(os.Stdout, os.Stderr, false, true),
+			&Config{
+				Bin:        "git",
+				WorkingDir: filepath.Join(testRepoRoot, cloneName),
+				Template:   filepath.Join(cwd, "testdata", testName+".md"),
+				Info: &Info{
+					Title:         "CHANGELOG Example",
+					RepositoryURL: "https://github.com/git-chglog/git-chglog",
+				},
+				Options: &Options{
+					Sort:      "date",
+					Unshallow: unshallow,
+					CommitFilters: map[string][]string{
+						"Type": {
+							"feat",
+						},
+					},
+					CommitSortBy:      "Scope",
+					CommitGroupBy:     "Type",
+					CommitGroupSortBy: "Title",
+					CommitGroupTitleMaps: map[string]string{
+						"feat": "Features",
+					},
+					HeaderPattern: "^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$",
+					HeaderPatternMaps: []string{
+						"Type",
+						"Scope",
+						"Subject",
+					},
+				},
+			})
+	}
+
+	buf := &bytes.Buffer{}
+	err = newGenerator(false).Generate(buf, "")
+
+	assert.Error(err)
+	assert.Contains(err.Error(), "git-tag does not exist")
+	assert.Contains(err.Error(), "shallow clone")
+
+	buf = &bytes.Buffer{}
+	err = newGenerator(true).Generate(buf, "")
+	expected := strings.TrimSpace(buf.String())
+
+	assert.Nil(err)
+	assert.Equal(`<a name="unreleased"></a>
+## [Unreleased]
+
+
+<a name="2.0.0"></a>
+## [2.0.0] - 2018-02-01
+### Features
+- **core:** version 2.0.0
+
+
+<a name="1.0.0"></a>
+## 1.0.0 - 2018-01-01
+### Features
+- **core:** version 1.0.0
+
+
+[Unreleased]: https://github.com/git-chglog/git-chglog/compare/2.0.0...HEAD
+[2.0.0]: https://github.com/git-chglog/git-chglog/compare/1.0.0...2.0.0`, expected)
+}
+
+func TestGeneratorWithJiraFixVersions(t *testing.T) {
+	assert := assert.New(t)
+	testName := "jira_fix_versions"
+
+	setup(testName, func(commit commitFunc, _ tagFunc, _ gitcmd.Client) {
+		commit("2018-01-01 00:00:00", "feat: Add login PROJ-1", "")
+		commit("2018-01-02 00:00:00", "fix: Fix login", "Fixes PROJ-2")
+		commit("2018-01-03 00:00:00", "chore: Update dependencies", "")
+		commit("2018-02-01 00:00:00", "feat: Add export PROJ-3", "")
+		commit("2018-02-02 00:00:00", "feat: Add import PROJ-4", "")
+	})
+
+	fixVersions := map[string]string{
+		"PROJ-1": `[{"name":"1.0","released":true,"releaseDate":"2018-01-10"}]`,
+		"PROJ-2": `[{"name":"1.0","released":true,"releaseDate":"2018-01-10"},{"name":"1.1","released":true,"releaseDate":"2018-02-10"}]`,
+		"PROJ-3": `[{"name":"2.0","released":false}]`,
+		"PROJ-4": `[]`,
+	}
+
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/rest/api/2/issue/")
+		_, _ = fmt.Fprintf(w, `{"key":"%s","fields":{"summary":"summary of %s","fixVersions":%s}}`, key, key, fixVersions[key])
+	})
+	defer server.Close()
+
+	gen := NewGenerator(NewLogger(os.Stdout, os.Stderr, false, true),
+		&Config{
+			Bin:        "git",
+			WorkingDir: filepath.Join(testRepoRoot, testName),
+			Template:   filepath.Join(cwd, "testdata", testName+".md"),
+			Info: &Info{
+				Title:         "CHANGELOG Example",
+				RepositoryURL: "https://github.com/git-chglog/git-chglog",
+			},
+			Options: &Options{
+				HeaderPattern: "^(\\w*)\\:\\s(.*)$",
+				HeaderPatternMaps: []string{
+					"Type",
+					"Subject",
+				},
+				JiraURL:             server.URL,
+				JiraIssueKeyPattern: "PROJ",
+				JiraFixVersions:     true,
+			},
+		})
+
+	buf := &bytes.Buffer{}
+	err := gen.Generate(buf, "")
+
+	assert.Nil(err)
+	assert.Equal(`## Unreleased
+- Add import PROJ-4
+
+## 2.0 - 2018-02-01 (after 1.1)
+- PROJ-3: summary of PROJ-3
+
+## 1.1 - 2018-02-10 (after 1.0)
+- PROJ-2: summary of PROJ-2
+
+## 1.0 - 2018-01-10
+- PROJ-2: summary of PROJ-2
+- PROJ-1: summary of PROJ-1`, strings.TrimSpace(buf.String()))
+
+	buf = &bytes.Buffer{}
+	err = gen.Generate(buf, "1.1")
+
+	assert.Nil(err)
+	assert.Contains(buf.String(), "## 1.1 - 2018-02-10")
+	assert.NotContains(buf.String(), "## 1.0")
+}
+
+func TestGeneratorWithStrict(t *testing.T) {
+	assert := assert.New(t)
+	testName := "strict"
+
+	setup(testName, func(commit commitFunc, tag tagFunc, _ gitcmd.Client) {
+		commit("2018-01-01 00:00:00", "feat: Add login PROJ-1", "")
+		commit("2018-01-02 00:00:00", "Update dependencies", "")
+		tag("1.0.0")
+	})
+
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	defer server.Close()
+
+	newGenerator := func(strict bool) *Generator {
+		return NewGenerator(NewLogger(os.Stdout, os.Stderr, true, true),
+			&Config{
+				Bin:        "git",
+				WorkingDir: filepath.Join(testRepoRoot, testName),
+				Template:   filepath.Join(cwd, "testdata", "type_scope_subject.md"),
+				Info: &Info{
+					Title:         "CHANGELOG Example",
+					RepositoryURL: "https://github.com/git-chglog/git-chglog",
+				},
+				Options: &Options{
+					HeaderPattern: "^(\\w*)\\:\\s(.*)$",
+					HeaderPatternMaps: []string{
+						"Type",
+						"Subject",
+					},
+					JiraURL:             server.URL,
+					JiraIssueKeyPattern: "PROJ",
+					Strict:              strict,
+				},
+			})
+	}
+
+	buf := &bytes.Buffer{}
+	err := newGenerator(false).Generate(buf, "0.9.0..1.0.0")
+	assert.Nil(err)
+	assert.NotEmpty(buf.String())
+
+	buf = &bytes.Buffer{}
+	err = newGenerator(true).Generate(buf, "0.9.0..1.0.0")
+
+	strictErr := &StrictError{}
+	assert.ErrorAs(err, &strictErr)
+	assert.Len(strictErr.Problems, 3)
+	assert.Equal("tag \"0.9.0\" of the query \"0.9.0..1.0.0\" does not exist", strictErr.Problems[0])
+	assert.Contains(strictErr.Problems[1], "header \"Update dependencies\" does not match the header pattern")
+	assert.Contains(strictErr.Problems[2], "Failed to parse Jira story PROJ-1: failed to authenticate to Jira")
+	assert.Contains(err.Error(), "3 problem(s) found in strict mode:\n- tag \"0.9.0\"")
+	assert.Empty(buf.String())
+
+	// queries failing on the missing tag
+	for _, query := range []string{"0.9.0", "..0.9.0"} {
+		err = newGenerator(false).Generate(&bytes.Buffer{}, query)
+		assert.Error(err)
+		assert.NotErrorAs(err, &strictErr, query)
+
+		err = newGenerator(true).Generate(&bytes.Buffer{}, query)
+		assert.ErrorAs(err, &strictErr, query)
+		assert.Equal(fmt.Sprintf("tag \"0.9.0\" of the query \"%s\" does not exist", query), strictErr.Problems[0])
+	}
+	// a reused generator reports the problems of the last run only, including the failed Jira issue
+	gen := newGenerator(true)
+	for i := 0; i < 2; i++ {
+		err = gen.Generate(&bytes.Buffer{}, "0.9.0..1.0.0")
+		assert.ErrorAs(err, &strictErr)
+		assert.Len(strictErr.Problems, 3)
+		assert.Contains(strictErr.Problems[2], "PROJ-1")
+	}
+}
+
+type stableOnlyProcessor struct{}
+
+func (*stableOnlyProcessor) Bootstrap(*Config) {}
+
+func (*stableOnlyProcessor) ProcessCommit(commit *Commit) *Commit {
+	return commit
+}
+
+func (*stableOnlyProcessor) ProcessRenderData(data *RenderData) {
+	versions := []*Version{}
+	for _, v := range data.Versions {
+		if !strings.Contains(v.Tag.Name, "-") {
+			versions = append(versions, v)
+		}
+	}
+	data.Versions = versions
+}
+
+func TestGeneratorGroupsProcessedVersions(t *testing.T) {
+	assert := assert.New(t)
+
+	tpl := filepath.Join(t.TempDir(), "groups.md")
+	assert.Nil(os.WriteFile(tpl, []byte("{{ range .VersionGroups }}{{ .Title }}:{{ range .Versions }} {{ .Tag.Name }}{{ end }};{{ end }}"), 0600))
+
+	gen := NewGenerator(NewLogger(os.Stdout, os.Stderr, true, true), &Config{
+		Bin:      "git",
+		Template: tpl,
+		Info:     &Info{},
+		Options: &Options{
+			VersionGroupBy: "major",
+			Processors:     []Processor{&stableOnlyProcessor{}},
+		},
+	})
+
+	versions := []*Version{
+		{Tag: &Tag{Name: "3.0.0-rc.1", Version: "3.0.0-rc.1"}},
+		{Tag: &Tag{Name: "2.1.0", Version: "2.1.0"}},
+		{Tag: &Tag{Name: "2.0.0", Version: "2.0.0"}},
+		{Tag: &Tag{Name: "1.0.0", Version: "1.0.0"}},
+	}
+
+	buf := &bytes.Buffer{}
+	assert.Nil(gen.render(buf, &Unreleased{}, versions))
+	assert.Equal("2.x: 2.1.0 2.0.0;1.x: 1.0.0;", buf.String())
+}
+
+func TestGeneratorContributorsOptIn(t *testing.T) {
+	assert := assert.New(t)
+
+	calls := 0
+	client := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			calls++
+			return "", nil
+		},
+	}
+
+	commits := []*Commit{
+		{Hash: &Hash{Long: "a1"}, Author: &Author{Name: "alice", Email: "alice@example.com"}},
+	}
+
+	for _, enabled := range []bool{false, true} {
+		calls = 0
+		gen := NewGenerator(NewLogger(os.Stdout, os.Stderr, true, true), &Config{
+			Bin:     "git",
+			Options: &Options{Contributors: enabled},
+		})
+		gen.contributorReader = newContributorReader(client, nil)
+
+		version, err := gen.newVersion(&Tag{Name: "1.0.0"}, commits)
+		assert.Nil(err)
+
+		if enabled {
+			assert.NotZero(calls)
+			assert.Equal([]*Contributor{{Name: "alice", Email: "alice@example.com"}}, version.Contributors)
+		} else {
+			assert.Zero(calls)
+			assert.Nil(version.Contributors)
+			assert.Nil(version.NewContributors)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"path/filepath"
@@ -19,6 +21,7 @@ type CLI struct {
 	configLoader     ConfigLoader
 	generator        Generator
 	processorFactory *ProcessorFactory
+	trackerFactory   *IssueTrackerFactory
 }
 
 // NewCLI ...
@@ -39,6 +42,7 @@ func NewCLI(
 		configLoader:     configLoader,
 		generator:        generator,
 		processorFactory: NewProcessorFactory(),
+		trackerFactory:   NewIssueTrackerFactory(),
 	}
 }
 
@@ -64,18 +68,28 @@ func (c *CLI) Run() int {
 		return ExitCodeError
 	}
 
-	w, err := c.createOutputWriter()
+	// the output is left untouched if the generation fails
+	buf := &bytes.Buffer{}
+	err = c.generator.Generate(c.logger, buf, c.ctx.Query, changelogConfig)
 	if err != nil {
 		c.logger.Error(err.Error())
+		if errors.As(err, new(*chglog.StrictError)) {
+			return ExitCodeStrict
+		}
 		return ExitCodeError
 	}
 
-	err = c.generator.Generate(c.logger, w, c.ctx.Query, changelogConfig)
+	w, err := c.createOutputWriter()
 	if err != nil {
 		c.logger.Error(err.Error())
 		return ExitCodeError
 	}
 
+	if _, err = w.Write(buf.Bytes()); err != nil {
+		c.logger.Error(err.Error())
+		return ExitCodeError
+	}
+
 	c.logger.Log(fmt.Sprintf(":sparkles: Generate of %s is completed! (%s)",
 		color.GreenString("\""+c.ctx.OutputPath+"\""),
 		color.New(color.Bold).SprintFunc()(time.Since(start).String()),
@@ -101,13 +115,19 @@ func (c *CLI) prepareConfig() (*Config, error) {
 }
 
 func (c *CLI) createChangelogConfig(config *Config) (*chglog.Config, error) {
-	processor, err := c.processorFactory.Create(config)
+	processors, err := c.processorFactory.CreateChain(config)
 	if err != nil {
 		return nil, err
 	}
 
 	changelogConfig := config.Convert(c.ctx)
-	changelogConfig.Options.Processor = processor
+	changelogConfig.Options.Processors = processors
+
+	token := orValue(c.ctx.TrackerToken, config.Options.Issues.Tracker.Token)
+	changelogConfig.Options.IssueTracker, err = c.trackerFactory.Create(config, changelogConfig, token)
+	if err != nil {
+		return nil, err
+	}
 
 	return changelogConfig, nil
 }
@@ -128,3 +128,53 @@ func TestCLIForFile(t *testing.T) {
 	out := regexp.MustCompile("\x1b\\[[^a-z]*[a-z]").ReplaceAllString(stdout.String(), "")
 	assert.Contains(out, "Generate of \"/dir/to/CHANGELOG.tpl\"")
 }
+
+func TestCLIForStrict(t *testing.T) {
+	assert := assert.New(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	created := false
+
+	mockFS := &mockFileSystem{
+		ReturnMkdirP: func(path string) error {
+			return nil
+		},
+		ReturnCreate: func(name string) (File, error) {
+			created = true
+			return &mockFile{}, nil
+		},
+	}
+
+	configLoader := &mockConfigLoaderImpl{
+		ReturnLoad: func(path string) (*Config, error) {
+			return &Config{}, nil
+		},
+	}
+
+	generator := &mockGeneratorImpl{
+		ReturnGenerate: func(w io.Writer, query string, config *chglog.Config) error {
+			assert.True(config.Options.Strict)
+			_, _ = w.Write([]byte("partial"))
+			return &chglog.StrictError{Problems: []string{"abc1234: header \"wip\" does not match the header pattern"}}
+		},
+	}
+
+	c := NewCLI(
+		&CLIContext{
+			WorkingDir: "/",
+			ConfigPath: "/.chglog/config.yml",
+			OutputPath: "/dir/to/CHANGELOG.md",
+			Strict:     true,
+			Stdout:     stdout,
+			Stderr:     stderr,
+		},
+		mockFS,
+		configLoader,
+		generator,
+	)
+
+	assert.Equal(ExitCodeStrict, c.Run())
+	assert.Contains(stderr.String(), "1 problem(s) found in strict mode:\n- abc1234: header \"wip\"")
+	assert.False(created)
+}
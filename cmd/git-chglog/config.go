@@ -1,8 +1,11 @@
 package main
 
 import (
+	"fmt"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"time"
 
 	"github.com/imdario/mergo"
 
@@ -29,6 +32,17 @@ type CommitGroupOptions struct {
 	TitleMaps  map[string]string `yaml:"title_maps"`
 }
 
+// VersionGroupOptions ...
+type VersionGroupOptions struct {
+	GroupBy string `yaml:"group_by"`
+}
+
+// CalVerOptions ...
+type CalVerOptions struct {
+	Bucket        string `yaml:"bucket"`
+	DeploymentLog string `yaml:"deployment_log"`
+}
+
 // PatternOptions ...
 type PatternOptions struct {
 	Pattern     string   `yaml:"pattern"`
@@ -37,7 +51,18 @@ type PatternOptions struct {
 
 // IssueOptions ...
 type IssueOptions struct {
-	Prefix []string `yaml:"prefix"`
+	Prefix            []string            `yaml:"prefix"`
+	PullRequestPrefix []string            `yaml:"pull_request_prefix"`
+	Tracker           IssueTrackerOptions `yaml:"tracker"`
+}
+
+// IssueTrackerOptions ...
+type IssueTrackerOptions struct {
+	Type       string `yaml:"type"`
+	URL        string `yaml:"url"`
+	Repository string `yaml:"repository"`
+	Token      string `yaml:"token"`
+	KeyPattern string `yaml:"key_pattern"`
 }
 
 // RefOptions ...
@@ -50,47 +75,86 @@ type NoteOptions struct {
 	Keywords []string `yaml:"keywords"`
 }
 
+// LinkOptions ...
+type LinkOptions struct {
+	Pattern string `yaml:"pattern"`
+	URL     string `yaml:"url"`
+	Text    string `yaml:"text"`
+}
+
+// JiraOAuthOptions ...
+type JiraOAuthOptions struct {
+	ConsumerKey    string `yaml:"consumer_key"`
+	PrivateKeyFile string `yaml:"private_key_file"`
+}
+
 // JiraClientInfoOptions ...
 type JiraClientInfoOptions struct {
-	Username string `yaml:"username"`
-	Token    string `yaml:"token"`
-	URL      string `yaml:"url"`
+	Username string           `yaml:"username"`
+	Token    string           `yaml:"token"`
+	URL      string           `yaml:"url"`
+	AuthType string           `yaml:"auth_type"`
+	OAuth    JiraOAuthOptions `yaml:"oauth"`
+	Timeout  time.Duration    `yaml:"timeout"`
+	Retries  int              `yaml:"retries"`
 }
 
 // JiraIssueOptions ...
 type JiraIssueOptions struct {
+	KeyPattern         string            `yaml:"key_pattern"`
 	TypeMaps           map[string]string `yaml:"type_maps"`
+	LabelTypeMaps      map[string]string `yaml:"label_type_maps"`
+	TypePrecedence     string            `yaml:"type_precedence"`
+	DefaultType        string            `yaml:"default_type"`
 	DescriptionPattern string            `yaml:"description_pattern"`
+	Fields             map[string]string `yaml:"fields"`
 }
 
 // JiraOptions ...
 type JiraOptions struct {
-	ClintInfo JiraClientInfoOptions `yaml:"info"`
-	Issue     JiraIssueOptions      `yaml:"issue"`
+	ClintInfo   JiraClientInfoOptions `yaml:"info"`
+	Issue       JiraIssueOptions      `yaml:"issue"`
+	FixVersions bool                  `yaml:"fix_versions"`
+	Export      string                `yaml:"export"`
+}
+
+// LintOptions ...
+type LintOptions struct {
+	Types  []string `yaml:"types"`
+	Scopes []string `yaml:"scopes"`
 }
 
 // Options ...
 type Options struct {
-	TagFilterPattern string             `yaml:"tag_filter_pattern"`
-	Sort             string             `yaml:"sort"`
-	Commits          CommitOptions      `yaml:"commits"`
-	CommitGroups     CommitGroupOptions `yaml:"commit_groups"`
-	Header           PatternOptions     `yaml:"header"`
-	Issues           IssueOptions       `yaml:"issues"`
-	Refs             RefOptions         `yaml:"refs"`
-	Merges           PatternOptions     `yaml:"merges"`
-	Reverts          PatternOptions     `yaml:"reverts"`
-	Notes            NoteOptions        `yaml:"notes"`
-	Jira             JiraOptions        `yaml:"jira"`
+	TagFilterPattern string              `yaml:"tag_filter_pattern"`
+	TagNamePattern   string              `yaml:"tag_name_pattern"`
+	Sort             string              `yaml:"sort"`
+	CalVer           CalVerOptions       `yaml:"calver"`
+	Commits          CommitOptions       `yaml:"commits"`
+	CommitGroups     CommitGroupOptions  `yaml:"commit_groups"`
+	VersionGroups    VersionGroupOptions `yaml:"version_groups"`
+	Header           PatternOptions      `yaml:"header"`
+	Issues           IssueOptions        `yaml:"issues"`
+	Refs             RefOptions          `yaml:"refs"`
+	Merges           PatternOptions      `yaml:"merges"`
+	Reverts          PatternOptions      `yaml:"reverts"`
+	Notes            NoteOptions         `yaml:"notes"`
+	Links            []LinkOptions       `yaml:"links"`
+	Processors       []string            `yaml:"processors"`
+	Aliases          map[string]string   `yaml:"aliases"`
+	Contributors     bool                `yaml:"contributors"`
+	Jira             JiraOptions         `yaml:"jira"`
+	Lint             LintOptions         `yaml:"lint"`
 }
 
 // Config ...
 type Config struct {
-	Bin      string  `yaml:"bin"`
-	Template string  `yaml:"template"`
-	Style    string  `yaml:"style"`
-	Info     Info    `yaml:"info"`
-	Options  Options `yaml:"options"`
+	Bin      string            `yaml:"bin"`
+	Template string            `yaml:"template"`
+	Style    string            `yaml:"style"`
+	Hosts    map[string]string `yaml:"hosts"`
+	Info     Info              `yaml:"info"`
+	Options  Options           `yaml:"options"`
 }
 
 // Normalize ...
@@ -124,12 +188,47 @@ func (config *Config) Normalize(ctx *CLIContext) error {
 
 	config.normalizeStyle()
 	config.normalizeTagSortBy()
+	config.normalizeVersionGroupBy()
+	config.normalizeCalVer()
+
+	if err = config.validatePatterns(); err != nil {
+		return err
+	}
+
+	return config.normalizeJiraTypePrecedence()
+}
+
+// validatePatterns reports an invalid regular expression of the options, the library would panic on it
+func (config *Config) validatePatterns() error {
+	patterns := []struct {
+		key     string
+		pattern string
+	}{
+		{"tag_name_pattern", config.Options.TagNamePattern},
+		{"jira.issue.key_pattern", config.Options.Jira.Issue.KeyPattern},
+		{"issues.tracker.key_pattern", config.Options.Issues.Tracker.KeyPattern},
+	}
+
+	for _, p := range patterns {
+		if _, err := regexp.Compile(p.pattern); err != nil {
+			return fmt.Errorf("invalid pattern of %s: %w", p.key, err)
+		}
+	}
 
 	return nil
 }
 
 // Normalize style
 func (config *Config) normalizeStyle() {
+	// style of a self-hosted instance from `hosts`
+	if config.Style == "" {
+		if obj, err := parseRepositoryURL(config.Info.RepositoryURL); err == nil {
+			if _, style, ok := lookupHost(config.Hosts, obj); ok {
+				config.Style = style
+			}
+		}
+	}
+
 	switch config.Style {
 	case "github":
 		config.normalizeStyleOfGitHub()
@@ -137,6 +236,10 @@ func (config *Config) normalizeStyle() {
 		config.normalizeStyleOfGitLab()
 	case "bitbucket":
 		config.normalizeStyleOfBitbucket()
+	case "gitea":
+		config.normalizeStyleOfGitea()
+	case "azure":
+		config.normalizeStyleOfAzure()
 	}
 }
 
@@ -153,6 +256,38 @@ func (config *Config) normalizeTagSortBy() {
 	}
 }
 
+func (config *Config) normalizeVersionGroupBy() {
+	switch {
+	case strings.EqualFold(config.Options.VersionGroups.GroupBy, "major"):
+		config.Options.VersionGroups.GroupBy = "major"
+	case strings.EqualFold(config.Options.VersionGroups.GroupBy, "minor"):
+		config.Options.VersionGroups.GroupBy = "minor"
+	default:
+		config.Options.VersionGroups.GroupBy = ""
+	}
+}
+
+func (config *Config) normalizeCalVer() {
+	config.Options.CalVer.Bucket = strings.ToLower(config.Options.CalVer.Bucket)
+}
+
+func (config *Config) normalizeJiraTypePrecedence() error {
+	issue := &config.Options.Jira.Issue
+
+	switch {
+	case issue.TypePrecedence == "":
+	case strings.EqualFold(issue.TypePrecedence, chglog.JiraTypePrecedenceJira):
+		issue.TypePrecedence = chglog.JiraTypePrecedenceJira
+	case strings.EqualFold(issue.TypePrecedence, chglog.JiraTypePrecedenceCommit):
+		issue.TypePrecedence = chglog.JiraTypePrecedenceCommit
+	default:
+		return fmt.Errorf("\"%s\" is not a valid type_precedence of jira.issue, use \"%s\" or \"%s\"",
+			issue.TypePrecedence, chglog.JiraTypePrecedenceJira, chglog.JiraTypePrecedenceCommit)
+	}
+
+	return nil
+}
+
 // For GitHub
 func (config *Config) normalizeStyleOfGitHub() {
 	opts := config.Options
@@ -199,6 +334,12 @@ func (config *Config) normalizeStyleOfGitLab() {
 		}
 	}
 
+	if len(opts.Issues.PullRequestPrefix) == 0 {
+		opts.Issues.PullRequestPrefix = []string{
+			"!",
+		}
+	}
+
 	if len(opts.Refs.Actions) == 0 {
 		opts.Refs.Actions = []string{
 			"close",
@@ -281,6 +422,90 @@ func (config *Config) normalizeStyleOfBitbucket() {
 	config.Options = opts
 }
 
+// For Gitea and Forgejo
+func (config *Config) normalizeStyleOfGitea() {
+	opts := config.Options
+
+	if len(opts.Issues.Prefix) == 0 {
+		opts.Issues.Prefix = []string{
+			"#",
+		}
+	}
+
+	if len(opts.Issues.PullRequestPrefix) == 0 {
+		opts.Issues.PullRequestPrefix = []string{
+			"!",
+		}
+	}
+
+	if len(opts.Refs.Actions) == 0 {
+		opts.Refs.Actions = []string{
+			"close",
+			"closes",
+			"closed",
+			"fix",
+			"fixes",
+			"fixed",
+			"resolve",
+			"resolves",
+			"resolved",
+		}
+	}
+
+	if opts.Merges.Pattern == "" && len(opts.Merges.PatternMaps) == 0 {
+		opts.Merges.Pattern = "^Merge pull request '.*' \\(#(\\d+)\\) from (.*) into .*$"
+		opts.Merges.PatternMaps = []string{
+			"Ref",
+			"Source",
+		}
+	}
+
+	config.Options = opts
+}
+
+// For Azure DevOps
+func (config *Config) normalizeStyleOfAzure() {
+	opts := config.Options
+
+	if len(opts.Issues.Prefix) == 0 {
+		opts.Issues.Prefix = []string{
+			"#",
+		}
+	}
+
+	if len(opts.Issues.PullRequestPrefix) == 0 {
+		opts.Issues.PullRequestPrefix = []string{
+			"!",
+		}
+	}
+
+	if len(opts.Refs.Actions) == 0 {
+		opts.Refs.Actions = []string{
+			"close",
+			"closes",
+			"closed",
+			"fix",
+			"fixes",
+			"fixed",
+			"resolve",
+			"resolves",
+			"resolved",
+			"complete",
+			"completes",
+			"completed",
+		}
+	}
+
+	if opts.Merges.Pattern == "" && len(opts.Merges.PatternMaps) == 0 {
+		opts.Merges.Pattern = "^Merged PR (\\d+): .*$"
+		opts.Merges.PatternMaps = []string{
+			"Ref",
+		}
+	}
+
+	config.Options = opts
+}
+
 func orValue(str1 string, str2 string) string {
 	if str1 != "" {
 		return str1
@@ -307,9 +532,16 @@ func (config *Config) Convert(ctx *CLIContext) *chglog.Config {
 		},
 		Options: &chglog.Options{
 			NextTag:                     ctx.NextTag,
+			NextTagRef:                  ctx.NextTagRef,
+			FetchTags:                   ctx.FetchTags,
+			Unshallow:                   ctx.Unshallow,
 			TagFilterPattern:            ctx.TagFilterPattern,
+			TagNamePattern:              opts.TagNamePattern,
 			Sort:                        orValue(ctx.Sort, opts.Sort),
+			CalVerBucket:                opts.CalVer.Bucket,
+			CalVerDeploymentLog:         opts.CalVer.DeploymentLog,
 			NoCaseSensitive:             ctx.NoCaseSensitive,
+			Strict:                      ctx.Strict,
 			Paths:                       ctx.Paths,
 			CommitFilters:               opts.Commits.Filters,
 			CommitSortBy:                opts.Commits.SortBy,
@@ -317,9 +549,14 @@ func (config *Config) Convert(ctx *CLIContext) *chglog.Config {
 			CommitGroupSortBy:           opts.CommitGroups.SortBy,
 			CommitGroupTitleMaps:        opts.CommitGroups.TitleMaps,
 			CommitGroupTitleOrder:       opts.CommitGroups.TitleOrder,
+			VersionGroupBy:              opts.VersionGroups.GroupBy,
 			HeaderPattern:               opts.Header.Pattern,
 			HeaderPatternMaps:           opts.Header.PatternMaps,
 			IssuePrefix:                 opts.Issues.Prefix,
+			IssueKeyPattern:             opts.Issues.Tracker.KeyPattern,
+			PullRequestPrefix:           opts.Issues.PullRequestPrefix,
+			Aliases:                     opts.Aliases,
+			Contributors:                opts.Contributors,
 			RefActions:                  opts.Refs.Actions,
 			MergePattern:                opts.Merges.Pattern,
 			MergePatternMaps:            opts.Merges.PatternMaps,
@@ -329,8 +566,22 @@ func (config *Config) Convert(ctx *CLIContext) *chglog.Config {
 			JiraUsername:                orValue(ctx.JiraUsername, opts.Jira.ClintInfo.Username),
 			JiraToken:                   orValue(ctx.JiraToken, opts.Jira.ClintInfo.Token),
 			JiraURL:                     orValue(ctx.JiraURL, opts.Jira.ClintInfo.URL),
+			JiraExport:                  orValue(ctx.JiraExport, opts.Jira.Export),
+			JiraAuthType:                orValue(ctx.JiraAuthType, opts.Jira.ClintInfo.AuthType),
+			JiraOAuthConsumerKey:        opts.Jira.ClintInfo.OAuth.ConsumerKey,
+			JiraOAuthPrivateKeyFile:     opts.Jira.ClintInfo.OAuth.PrivateKeyFile,
+			JiraTimeout:                 opts.Jira.ClintInfo.Timeout,
+			JiraMaxRetries:              opts.Jira.ClintInfo.Retries,
+			JiraIssueKeyPattern:         opts.Jira.Issue.KeyPattern,
 			JiraTypeMaps:                opts.Jira.Issue.TypeMaps,
+			JiraLabelTypeMaps:           opts.Jira.Issue.LabelTypeMaps,
+			JiraTypePrecedence:          opts.Jira.Issue.TypePrecedence,
+			JiraTypeDefault:             opts.Jira.Issue.DefaultType,
 			JiraIssueDescriptionPattern: opts.Jira.Issue.DescriptionPattern,
+			JiraFields:                  opts.Jira.Issue.Fields,
+			JiraFixVersions:             opts.Jira.FixVersions,
+			LintTypes:                   opts.Lint.Types,
+			LintScopes:                  opts.Lint.Scopes,
 		},
 	}
 }
@@ -40,6 +40,86 @@ func TestConfigNormalize(t *testing.T) {
 
 	assert.Nil(err)
 	assert.Equal(filepath.Join(cwd, "CHANGELOG.tpl.md"), config.Template)
+
+	// style from hosts
+	config = &Config{
+		Hosts: map[string]string{
+			"git.example.com/gitlab": "gitlab",
+		},
+		Info: Info{
+			RepositoryURL: "https://git.example.com/gitlab/foo/bar",
+		},
+	}
+
+	err = config.Normalize(&CLIContext{
+		ConfigPath: filepath.FromSlash("/test/config.yml"),
+	})
+
+	assert.Nil(err)
+	assert.Equal("gitlab", config.Style)
+	assert.Equal([]string{"!"}, config.Options.Issues.PullRequestPrefix)
+	assert.Equal("^Merge branch '.*' into '(.*)'$", config.Options.Merges.Pattern)
+
+	// pull requests of Gitea
+	config = &Config{
+		Style: "gitea",
+	}
+
+	err = config.Normalize(&CLIContext{
+		ConfigPath: filepath.FromSlash("/test/config.yml"),
+	})
+
+	assert.Nil(err)
+	assert.Equal([]string{"#"}, config.Options.Issues.Prefix)
+	assert.Equal([]string{"!"}, config.Options.Issues.PullRequestPrefix)
+
+	// invalid patterns
+	config = &Config{}
+	config.Options.TagNamePattern = "^v(?P<Version>.*"
+
+	err = config.Normalize(&CLIContext{
+		ConfigPath: filepath.FromSlash("/test/config.yml"),
+	})
+
+	assert.EqualError(err, "invalid pattern of tag_name_pattern: error parsing regexp: missing closing ): `^v(?P<Version>.*`")
+
+	config = &Config{}
+	config.Options.Jira.Issue.KeyPattern = "PROJ|OPS["
+
+	err = config.Normalize(&CLIContext{
+		ConfigPath: filepath.FromSlash("/test/config.yml"),
+	})
+
+	assert.EqualError(err, "invalid pattern of jira.issue.key_pattern: error parsing regexp: missing closing ]: `[`")
+
+	config = &Config{}
+	config.Options.Issues.Tracker.KeyPattern = "ENG)"
+
+	err = config.Normalize(&CLIContext{
+		ConfigPath: filepath.FromSlash("/test/config.yml"),
+	})
+
+	assert.EqualError(err, "invalid pattern of issues.tracker.key_pattern: error parsing regexp: unexpected ): `ENG)`")
+
+	// type precedence of Jira
+	config = &Config{}
+	config.Options.Jira.Issue.TypePrecedence = "Commit"
+
+	err = config.Normalize(&CLIContext{
+		ConfigPath: filepath.FromSlash("/test/config.yml"),
+	})
+
+	assert.Nil(err)
+	assert.Equal("commit", config.Options.Jira.Issue.TypePrecedence)
+
+	config = &Config{}
+	config.Options.Jira.Issue.TypePrecedence = "comit"
+
+	err = config.Normalize(&CLIContext{
+		ConfigPath: filepath.FromSlash("/test/config.yml"),
+	})
+
+	assert.EqualError(err, "\"comit\" is not a valid type_precedence of jira.issue, use \"jira\" or \"commit\"")
 }
 
 func TestConfigConvert(t *testing.T) {
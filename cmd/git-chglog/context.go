@@ -17,12 +17,19 @@ type CLIContext struct {
 	NoColor          bool
 	NoEmoji          bool
 	NoCaseSensitive  bool
+	Strict           bool
 	Query            string
 	NextTag          string
+	NextTagRef       string
+	FetchTags        bool
+	Unshallow        bool
 	TagFilterPattern string
 	JiraUsername     string
 	JiraToken        string
 	JiraURL          string
+	JiraAuthType     string
+	JiraExport       string
+	TrackerToken     string
 	Paths            []string
 	Sort             string
 }
@@ -33,3 +40,16 @@ type InitContext struct {
 	Stdout     io.Writer
 	Stderr     io.Writer
 }
+
+// HookContext ...
+type HookContext struct {
+	WorkingDir      string
+	Stdout          io.Writer
+	Stderr          io.Writer
+	ConfigPath      string
+	NoColor         bool
+	NoEmoji         bool
+	NoCaseSensitive bool
+	Force           bool
+	MessageFile     string
+}
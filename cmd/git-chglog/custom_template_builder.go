@@ -48,12 +48,15 @@ func (*customTemplateBuilderImpl) versionHeader(style, template string) string {
 
 	// parts
 	switch style {
-	case styleGitHub, styleGitLab:
+	case styleGitHub, styleGitLab, styleGitea:
 		tpl = templateTagNameAnchor
 		tagName = "{{ if .Tag.Previous }}[{{ .Tag.Name }}]({{ $.Info.RepositoryURL }}/compare/{{ .Tag.Previous.Name }}...{{ .Tag.Name }}){{ else }}{{ .Tag.Name }}{{ end }}"
 	case styleBitbucket:
 		tpl = templateTagNameAnchor
 		tagName = "{{ if .Tag.Previous }}[{{ .Tag.Name }}]({{ $.Info.RepositoryURL }}/compare/{{ .Tag.Name }}..{{ .Tag.Previous.Name }}){{ else }}{{ .Tag.Name }}{{ end }}"
+	case styleAzure:
+		tpl = templateTagNameAnchor
+		tagName = "{{ if .Tag.Previous }}[{{ .Tag.Name }}]({{ $.Info.RepositoryURL }}/branchCompare?baseVersion=GT{{ .Tag.Previous.Name }}&targetVersion=GT{{ .Tag.Name }}){{ else }}{{ .Tag.Name }}{{ end }}"
 	}
 
 	// format
@@ -123,7 +126,7 @@ func (t *customTemplateBuilderImpl) merges(style string) string {
 	var title string
 
 	switch style {
-	case styleGitHub, styleBitbucket:
+	case styleGitHub, styleBitbucket, styleGitea, styleAzure:
 		title = "Pull Requests"
 	case styleGitLab:
 		title = "Merge Requests"
@@ -354,3 +354,46 @@ func TestCustomTemplateBuilderCool(t *testing.T) {
 {{ end -}}
 {{ end -}}`, out)
 }
+
+func TestCustomTemplateBuilderAzure(t *testing.T) {
+	assert := assert.New(t)
+	builder := NewCustomTemplateBuilder()
+
+	out, err := builder.Build(&Answer{
+		Style:               styleAzure,
+		CommitMessageFormat: fmtSubject.display,
+		Template:            tplStandard.display,
+		IncludeMerges:       true,
+		IncludeReverts:      false,
+	})
+
+	assert.Nil(err)
+	assert.Equal(`{{ range .Versions }}
+<a name="{{ .Tag.Name }}"></a>
+## {{ if .Tag.Previous }}[{{ .Tag.Name }}]({{ $.Info.RepositoryURL }}/branchCompare?baseVersion=GT{{ .Tag.Previous.Name }}&targetVersion=GT{{ .Tag.Name }}){{ else }}{{ .Tag.Name }}{{ end }} ({{ datetime "2006-01-02" .Tag.Date }})
+
+{{ range .CommitGroups -}}
+{{ range .Commits -}}
+* {{ .Header }}
+{{ end }}
+{{ end -}}
+
+{{- if .MergeCommits -}}
+### Pull Requests
+
+{{ range .MergeCommits -}}
+* {{ .Header }}
+{{ end }}
+{{ end -}}
+
+{{- if .NoteGroups -}}
+{{ range .NoteGroups -}}
+### {{ .Title }}
+
+{{ range .Notes }}
+{{ .Body }}
+{{ end }}
+{{ end -}}
+{{ end -}}
+{{ end -}}`, out)
+}
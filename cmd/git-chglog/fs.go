@@ -11,6 +11,7 @@ type FileSystem interface {
 	MkdirP(path string) error
 	Create(name string) (File, error)
 	WriteFile(path string, content []byte) error
+	ReadFile(path string) ([]byte, error)
 }
 
 // File ...
@@ -49,3 +50,8 @@ func (*osFileSystem) WriteFile(path string, content []byte) error {
 	//nolint:gosec
 	return os.WriteFile(path, content, os.ModePerm)
 }
+
+func (*osFileSystem) ReadFile(path string) ([]byte, error) {
+	//nolint:gosec
+	return os.ReadFile(path)
+}
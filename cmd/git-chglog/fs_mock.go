@@ -5,6 +5,7 @@ type mockFileSystem struct {
 	ReturnMkdirP    func(string) error
 	ReturnCreate    func(string) (File, error)
 	ReturnWriteFile func(string, []byte) error
+	ReturnReadFile  func(string) ([]byte, error)
 }
 
 func (m *mockFileSystem) Exists(path string) bool {
@@ -23,6 +24,10 @@ func (m *mockFileSystem) WriteFile(path string, content []byte) error {
 	return m.ReturnWriteFile(path, content)
 }
 
+func (m *mockFileSystem) ReadFile(path string) ([]byte, error) {
+	return m.ReturnReadFile(path)
+}
+
 type mockFile struct {
 	File
 	ReturnWrite func([]byte) (int, error)
@@ -0,0 +1,14 @@
+package main
+
+import (
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+)
+
+type mockGitClient struct {
+	gitcmd.Client
+	ReturnExec func(string, ...string) (string, error)
+}
+
+func (m *mockGitClient) Exec(subcmd string, args ...string) (string, error) {
+	return m.ReturnExec(subcmd, args...)
+}
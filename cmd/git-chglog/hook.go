@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+
+	chglog "github.com/git-chglog/git-chglog"
+)
+
+// hookCommand is contained in every installed hook, a hook without it is not overwritten unless forced
+const hookCommand = "git-chglog hook commit-msg"
+
+// HookInstaller writes a `commit-msg` hook running `git-chglog hook commit-msg`
+type HookInstaller struct {
+	ctx    *HookContext
+	fs     FileSystem
+	client gitcmd.Client
+	logger *chglog.Logger
+}
+
+// NewHookInstaller ...
+func NewHookInstaller(ctx *HookContext, fs FileSystem, client gitcmd.Client) *HookInstaller {
+	return &HookInstaller{
+		ctx:    ctx,
+		fs:     fs,
+		client: client,
+		logger: chglog.NewLogger(ctx.Stdout, ctx.Stderr, false, ctx.NoEmoji),
+	}
+}
+
+// Run ...
+func (h *HookInstaller) Run() int {
+	if h.ctx.NoColor {
+		color.NoColor = true
+	}
+
+	path, err := h.install()
+	if err != nil {
+		h.logger.Error(err.Error())
+		return ExitCodeError
+	}
+
+	h.logger.Log(fmt.Sprintf(":sparkles: The commit-msg hook is installed in %s", color.GreenString("\""+path+"\"")))
+
+	return ExitCodeOK
+}
+
+func (h *HookInstaller) install() (string, error) {
+	// honors `core.hooksPath` and worktrees
+	dir, err := h.client.Exec("rev-parse", "--git-path", "hooks")
+	if err != nil {
+		return "", fmt.Errorf("failed to find the hooks directory: %w", err)
+	}
+
+	dir = strings.TrimSpace(dir)
+	if !filepath.IsAbs(dir) {
+		dir = filepath.Join(h.ctx.WorkingDir, dir)
+	}
+	path := filepath.Join(dir, "commit-msg")
+
+	if h.fs.Exists(path) && !h.ctx.Force {
+		content, err := h.fs.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		if !strings.Contains(string(content), hookCommand) {
+			return "", fmt.Errorf("\"%s\" already exists, use --force to overwrite it", path)
+		}
+	}
+
+	err = h.fs.MkdirP(dir)
+	if err != nil {
+		return "", err
+	}
+
+	return path, h.fs.WriteFile(path, []byte(hookScript(h.ctx.ConfigPath)))
+}
+
+func hookScript(configPath string) string {
+	quoted := "'" + strings.ReplaceAll(configPath, "'", `'\''`) + "'"
+
+	return fmt.Sprintf(`#!/bin/sh
+# Installed by "git-chglog hook install", validates the commit message
+# against the configuration of git-chglog.
+exec %s --config %s "$1"
+`, hookCommand, quoted)
+}
+
+// CommitMsgCLI validates the message file given to a `commit-msg` hook
+type CommitMsgCLI struct {
+	ctx          *HookContext
+	fs           FileSystem
+	logger       *chglog.Logger
+	configLoader ConfigLoader
+	linter       Linter
+}
+
+// NewCommitMsgCLI ...
+func NewCommitMsgCLI(
+	ctx *HookContext, fs FileSystem,
+	configLoader ConfigLoader,
+	linter Linter,
+) *CommitMsgCLI {
+	return &CommitMsgCLI{
+		ctx:          ctx,
+		fs:           fs,
+		logger:       chglog.NewLogger(ctx.Stdout, ctx.Stderr, true, ctx.NoEmoji),
+		configLoader: configLoader,
+		linter:       linter,
+	}
+}
+
+// Run returns `ExitCodeError` to abort the commit if the message breaks the conventions
+func (c *CommitMsgCLI) Run() int {
+	if c.ctx.NoColor {
+		color.NoColor = true
+	}
+
+	message, err := c.fs.ReadFile(c.ctx.MessageFile)
+	if err != nil {
+		c.logger.Error(err.Error())
+		return ExitCodeError
+	}
+
+	cliContext := &CLIContext{
+		WorkingDir:      c.ctx.WorkingDir,
+		Stdout:          c.ctx.Stdout,
+		Stderr:          c.ctx.Stderr,
+		ConfigPath:      c.ctx.ConfigPath,
+		NoCaseSensitive: c.ctx.NoCaseSensitive,
+	}
+
+	config, err := c.configLoader.Load(cliContext.ConfigPath)
+	if err != nil {
+		c.logger.Error(err.Error())
+		return ExitCodeError
+	}
+
+	err = config.Normalize(cliContext)
+	if err != nil {
+		c.logger.Error(err.Error())
+		return ExitCodeError
+	}
+
+	problems := c.linter.LintMessage(c.logger, string(message), config.Convert(cliContext))
+	if len(problems) == 0 {
+		return ExitCodeOK
+	}
+
+	c.logger.Error(fmt.Sprintf("The commit message \"%s\" breaks the conventions of \"%s\":", problems[0].Header, c.ctx.ConfigPath))
+	for _, p := range problems {
+		fmt.Fprintf(c.ctx.Stderr, "- %s\n", p.Message)
+		printSuggestion(c.ctx.Stderr, p)
+	}
+	fmt.Fprintf(c.ctx.Stderr, "\nThe message is kept in \"%[1]s\", reuse it with \"git commit --edit --file %[1]s\".\n", c.ctx.MessageFile)
+
+	return ExitCodeError
+}
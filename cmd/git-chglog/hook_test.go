@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	chglog "github.com/git-chglog/git-chglog"
+)
+
+func TestHookInstaller(t *testing.T) {
+	assert := assert.New(t)
+
+	client := &mockGitClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			if subcmd != "rev-parse" || strings.Join(args, " ") != "--git-path hooks" {
+				return "", errors.New("")
+			}
+			return ".git/hooks\n", nil
+		},
+	}
+
+	existing := ""
+	written := map[string]string{}
+	mockFS := &mockFileSystem{
+		ReturnExists: func(path string) bool {
+			return existing != ""
+		},
+		ReturnReadFile: func(path string) ([]byte, error) {
+			return []byte(existing), nil
+		},
+		ReturnMkdirP: func(path string) error {
+			return nil
+		},
+		ReturnWriteFile: func(path string, content []byte) error {
+			written[filepath.ToSlash(path)] = string(content)
+			return nil
+		},
+	}
+
+	install := func(force bool) int {
+		written = map[string]string{}
+		return NewHookInstaller(
+			&HookContext{
+				WorkingDir: "/repo",
+				Stdout:     &bytes.Buffer{},
+				Stderr:     &bytes.Buffer{},
+				ConfigPath: "it's/config.yml",
+				NoEmoji:    true,
+				Force:      force,
+			},
+			mockFS,
+			client,
+		).Run()
+	}
+
+	// new hook
+	assert.Equal(ExitCodeOK, install(false))
+	hook := written["/repo/.git/hooks/commit-msg"]
+	assert.True(strings.HasPrefix(hook, "#!/bin/sh\n"))
+	assert.Contains(hook, `exec git-chglog hook commit-msg --config 'it'\''s/config.yml' "$1"`)
+
+	// previously installed hook
+	existing = hook
+	assert.Equal(ExitCodeOK, install(false))
+	assert.Len(written, 1)
+
+	// hook of another tool
+	existing = "#!/bin/sh\nnpx commitlint --edit \"$1\"\n"
+	assert.Equal(ExitCodeError, install(false))
+	assert.Len(written, 0)
+
+	assert.Equal(ExitCodeOK, install(true))
+	assert.Len(written, 1)
+}
+
+func TestCommitMsgCLI(t *testing.T) {
+	assert := assert.New(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	mockFS := &mockFileSystem{
+		ReturnReadFile: func(path string) ([]byte, error) {
+			if path != ".git/COMMIT_EDITMSG" {
+				return nil, errors.New("")
+			}
+			return []byte("Feat: Add login\n"), nil
+		},
+	}
+
+	configLoader := &mockConfigLoaderImpl{
+		ReturnLoad: func(path string) (*Config, error) {
+			return &Config{}, nil
+		},
+	}
+
+	var problems []*chglog.LintProblem
+	linter := &mockLinterImpl{
+		ReturnLintMessage: func(message string, config *chglog.Config) []*chglog.LintProblem {
+			assert.Equal("Feat: Add login\n", message)
+			return problems
+		},
+	}
+
+	run := func() int {
+		stdout.Reset()
+		stderr.Reset()
+		return NewCommitMsgCLI(
+			&HookContext{
+				WorkingDir:  "/repo",
+				Stdout:      stdout,
+				Stderr:      stderr,
+				ConfigPath:  ".chglog/config.yml",
+				NoColor:     true,
+				NoEmoji:     true,
+				MessageFile: ".git/COMMIT_EDITMSG",
+			},
+			mockFS,
+			configLoader,
+			linter,
+		).Run()
+	}
+
+	// valid message
+	assert.Equal(ExitCodeOK, run())
+	assert.Equal("", stdout.String())
+	assert.Equal("", stderr.String())
+
+	// invalid message
+	problems = []*chglog.LintProblem{
+		{
+			Header:     "Feat: Add login",
+			Message:    "type \"Feat\" is not allowed, use one of: feat, fix",
+			Suggestion: "did you mean \"feat\"?",
+		},
+	}
+
+	assert.Equal(ExitCodeError, run())
+	assert.Equal("", stdout.String())
+	assert.Contains(stderr.String(), "The commit message \"Feat: Add login\" breaks the conventions of \".chglog/config.yml\":")
+	assert.Contains(stderr.String(), "- type \"Feat\" is not allowed, use one of: feat, fix\n  did you mean \"feat\"?\n")
+	assert.Contains(stderr.String(), "git commit --edit --file .git/COMMIT_EDITMSG")
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	chglog "github.com/git-chglog/git-chglog"
+)
+
+// IssueTrackerFactory ...
+type IssueTrackerFactory struct{}
+
+// NewIssueTrackerFactory ...
+func NewIssueTrackerFactory() *IssueTrackerFactory {
+	return &IssueTrackerFactory{}
+}
+
+// Create creates the tracker of `options.issues.tracker`, nil if not configured.
+// The API URL and the repository default to the ones of `info.repository_url`,
+// a forge installed under a sub-path of `hosts` keeps it in the API URL.
+func (factory *IssueTrackerFactory) Create(config *Config, changelogConfig *chglog.Config, token string) (chglog.IssueTracker, error) {
+	tracker := config.Options.Issues.Tracker
+
+	switch strings.ToLower(tracker.Type) {
+	case "":
+		return nil, nil
+	case "jira":
+		// the issues are shared with those the generator fetches for `JiraIssues`
+		return chglog.NewJiraIssueTracker(nil, changelogConfig.Options.JiraURL), nil
+	case "github":
+		apiURL, repository, err := factory.resolve(config, func(scheme, base string) string {
+			if base == "github.com" {
+				return "https://api.github.com"
+			}
+			return fmt.Sprintf("%s://%s/api/v3", scheme, base)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return chglog.NewGitHubIssueTracker(apiURL, repository, token), nil
+	case "gitlab":
+		apiURL, repository, err := factory.resolve(config, func(scheme, base string) string {
+			return fmt.Sprintf("%s://%s/api/v4", scheme, base)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return chglog.NewGitLabIssueTracker(apiURL, repository, token), nil
+	case "linear":
+		return chglog.NewLinearIssueTracker(orValue(tracker.URL, "https://api.linear.app"), token), nil
+	case "youtrack":
+		if tracker.URL == "" {
+			return nil, errors.New("the YouTrack issue tracker requires \"url\" of \"issues.tracker\"")
+		}
+		return chglog.NewYouTrackIssueTracker(tracker.URL, token), nil
+	default:
+		return nil, fmt.Errorf("\"%s\" is not a supported issue tracker, use \"github\", \"gitlab\", \"jira\", \"linear\" or \"youtrack\"", tracker.Type)
+	}
+}
+
+// resolve returns the API URL and the repository of the tracker, from the options or the repository URL
+func (*IssueTrackerFactory) resolve(config *Config, defaultAPIURL func(scheme, base string) string) (string, string, error) {
+	tracker := config.Options.Issues.Tracker
+
+	obj, err := parseRepositoryURL(config.Info.RepositoryURL)
+	if err != nil {
+		return "", "", err
+	}
+
+	// self-hosted instances, possibly installed under a sub-path
+	base, path := obj.Host, obj.Path
+	if prefix, _, ok := lookupHost(config.Hosts, obj); ok {
+		base, path = prefix, obj.Path[len(prefix)-len(obj.Host):]
+	}
+
+	apiURL := tracker.URL
+	if apiURL == "" && base != "" {
+		apiURL = defaultAPIURL(obj.Scheme, base)
+	}
+
+	repository := tracker.Repository
+	if repository == "" {
+		repository = strings.Trim(path, "/")
+	}
+
+	if apiURL == "" || repository == "" {
+		return "", "", errors.New("the issue tracker requires \"repository_url\", or \"url\" and \"repository\" of \"issues.tracker\"")
+	}
+
+	return apiURL, repository, nil
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	chglog "github.com/git-chglog/git-chglog"
+)
+
+func TestIssueTrackerFactory(t *testing.T) {
+	assert := assert.New(t)
+	factory := NewIssueTrackerFactory()
+	changelogConfig := &chglog.Config{Options: &chglog.Options{}}
+
+	newConfig := func(repositoryURL string, tracker IssueTrackerOptions) *Config {
+		return &Config{
+			Info: Info{
+				RepositoryURL: repositoryURL,
+			},
+			Options: Options{
+				Issues: IssueOptions{
+					Tracker: tracker,
+				},
+			},
+		}
+	}
+
+	// not configured
+	tracker, err := factory.Create(newConfig("https://github.com/owner/repo", IssueTrackerOptions{}), changelogConfig, "")
+	assert.Nil(err)
+	assert.Nil(tracker)
+
+	for _, typ := range []string{"github", "GitLab", "jira", "linear"} {
+		tracker, err = factory.Create(newConfig("https://github.com/owner/repo", IssueTrackerOptions{Type: typ}), changelogConfig, "")
+		assert.Nil(err)
+		assert.NotNil(tracker)
+	}
+
+	tracker, err = factory.Create(newConfig("https://github.com/owner/repo", IssueTrackerOptions{Type: "YouTrack", URL: "https://example.youtrack.cloud"}), changelogConfig, "")
+	assert.Nil(err)
+	assert.NotNil(tracker)
+
+	_, err = factory.Create(newConfig("https://github.com/owner/repo", IssueTrackerOptions{Type: "youtrack"}), changelogConfig, "")
+	assert.EqualError(err, "the YouTrack issue tracker requires \"url\" of \"issues.tracker\"")
+
+	_, err = factory.Create(newConfig("https://github.com/owner/repo", IssueTrackerOptions{Type: "redmine"}), changelogConfig, "")
+	assert.EqualError(err, "\"redmine\" is not a supported issue tracker, use \"github\", \"gitlab\", \"jira\", \"linear\" or \"youtrack\"")
+
+	_, err = factory.Create(newConfig("", IssueTrackerOptions{Type: "github"}), changelogConfig, "")
+	assert.Error(err)
+}
+
+func TestIssueTrackerFactoryResolve(t *testing.T) {
+	assert := assert.New(t)
+	factory := NewIssueTrackerFactory()
+
+	gitHubAPI := func(scheme, base string) string {
+		if base == "github.com" {
+			return "https://api.github.com"
+		}
+		return scheme + "://" + base + "/api/v3"
+	}
+
+	hosts := map[string]string{
+		"git.example.com/gitlab": "gitlab",
+	}
+
+	table := []struct {
+		hosts         map[string]string
+		repositoryURL string
+		tracker       IssueTrackerOptions
+		apiURL        string
+		repository    string
+	}{
+		{nil, "https://github.com/owner/repo", IssueTrackerOptions{}, "https://api.github.com", "owner/repo"},
+		{nil, "git@ghe.example.com:owner/repo.git", IssueTrackerOptions{}, "https://ghe.example.com/api/v3", "owner/repo"},
+		{nil, "https://github.com/owner/repo", IssueTrackerOptions{URL: "https://proxy.example.com", Repository: "owner/issues"}, "https://proxy.example.com", "owner/issues"},
+		{nil, "", IssueTrackerOptions{URL: "https://api.github.com", Repository: "owner/repo"}, "https://api.github.com", "owner/repo"},
+		{hosts, "https://git.example.com/gitlab/group/project", IssueTrackerOptions{}, "https://git.example.com/gitlab/api/v3", "group/project"},
+		{hosts, "https://git.example.com/owner/repo", IssueTrackerOptions{}, "https://git.example.com/api/v3", "owner/repo"},
+	}
+
+	for _, sa := range table {
+		apiURL, repository, err := factory.resolve(&Config{
+			Hosts: sa.hosts,
+			Info: Info{
+				RepositoryURL: sa.repositoryURL,
+			},
+			Options: Options{
+				Issues: IssueOptions{
+					Tracker: sa.tracker,
+				},
+			},
+		}, gitHubAPI)
+
+		assert.Nil(err)
+		assert.Equal(sa.apiURL, apiURL)
+		assert.Equal(sa.repository, repository)
+	}
+}
@@ -52,9 +52,12 @@ func (t *kacTemplateBuilderImpl) unreleased(style, format string) string {
 	)
 
 	switch style {
-	case styleGitHub, styleGitLab, styleBitbucket:
+	case styleGitHub, styleGitLab, styleBitbucket, styleGitea:
 		id = "<a name=\"unreleased\"></a>\n"
 		title = fmt.Sprintf("[%s]", title)
+	case styleAzure:
+		// Azure DevOps can not compare a tag with HEAD
+		id = "<a name=\"unreleased\"></a>\n"
 	}
 
 	return fmt.Sprintf(`{{ if .Versions -}}
@@ -74,7 +77,7 @@ func (t *kacTemplateBuilderImpl) versionHeader(style string) string {
 	)
 
 	switch style {
-	case styleGitHub, styleGitLab, styleBitbucket:
+	case styleGitHub, styleGitLab, styleBitbucket, styleGitea, styleAzure:
 		id = templateTagNameAnchor
 		tagName = "{{ if .Tag.Previous }}[{{ .Tag.Name }}]{{ else }}{{ .Tag.Name }}{{ end }}"
 	}
@@ -121,7 +124,7 @@ func (t *kacTemplateBuilderImpl) merges(style string) string {
 	var title string
 
 	switch style {
-	case styleGitHub, styleBitbucket:
+	case styleGitHub, styleBitbucket, styleGitea, styleAzure:
 		title = "Pull Requests"
 	case styleGitLab:
 		title = "Merge Requests"
@@ -153,7 +156,7 @@ func (*kacTemplateBuilderImpl) notes() string {
 
 func (*kacTemplateBuilderImpl) footer(style string) string {
 	switch style {
-	case styleGitHub, styleGitLab:
+	case styleGitHub, styleGitLab, styleGitea:
 		return `
 
 {{- if .Versions }}
@@ -174,6 +177,16 @@ func (*kacTemplateBuilderImpl) footer(style string) string {
 [{{ .Tag.Name }}]: {{ $.Info.RepositoryURL }}/compare/{{ .Tag.Name }}..{{ .Tag.Previous.Name }}
 {{ end -}}
 {{ end -}}
+{{ end -}}`
+	case styleAzure:
+		return `
+
+{{- if .Versions }}
+{{ range .Versions -}}
+{{ if .Tag.Previous -}}
+[{{ .Tag.Name }}]: {{ $.Info.RepositoryURL }}/branchCompare?baseVersion=GT{{ .Tag.Previous.Name }}&targetVersion=GT{{ .Tag.Name }}
+{{ end -}}
+{{ end -}}
 {{ end -}}`
 	default:
 		return ""
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fatih/color"
+
+	chglog "github.com/git-chglog/git-chglog"
+)
+
+// LintCLI ...
+type LintCLI struct {
+	ctx          *CLIContext
+	logger       *chglog.Logger
+	configLoader ConfigLoader
+	linter       Linter
+}
+
+// NewLintCLI ...
+func NewLintCLI(
+	ctx *CLIContext,
+	configLoader ConfigLoader,
+	linter Linter,
+) *LintCLI {
+	return &LintCLI{
+		ctx:          ctx,
+		logger:       chglog.NewLogger(ctx.Stdout, ctx.Stderr, ctx.Silent, ctx.NoEmoji),
+		configLoader: configLoader,
+		linter:       linter,
+	}
+}
+
+// Run lints the commits of `ctx.Query` and prints a line per problem
+func (c *LintCLI) Run() int {
+	if c.ctx.NoColor {
+		color.NoColor = true
+	}
+
+	config, err := c.configLoader.Load(c.ctx.ConfigPath)
+	if err != nil {
+		c.logger.Error(err.Error())
+		return ExitCodeError
+	}
+
+	err = config.Normalize(c.ctx)
+	if err != nil {
+		c.logger.Error(err.Error())
+		return ExitCodeError
+	}
+
+	problems, err := c.linter.Lint(c.logger, c.ctx.Query, config.Convert(c.ctx))
+	if err != nil {
+		c.logger.Error(err.Error())
+		return ExitCodeError
+	}
+
+	for _, p := range problems {
+		fmt.Fprintf(c.ctx.Stdout, "%s %s: %s\n",
+			color.YellowString(p.Hash.Short),
+			p.Header,
+			p.Message,
+		)
+		printSuggestion(c.ctx.Stdout, p)
+	}
+
+	if len(problems) > 0 {
+		c.logger.Error(fmt.Sprintf("%d problem(s) found in \"%s\"", len(problems), c.ctx.Query))
+		return ExitCodeError
+	}
+
+	c.logger.Log(fmt.Sprintf(":sparkles: No problem found in %s", color.GreenString("\""+c.ctx.Query+"\"")))
+
+	return ExitCodeOK
+}
+
+func printSuggestion(w io.Writer, p *chglog.LintProblem) {
+	if p.Suggestion != "" {
+		fmt.Fprintf(w, "  %s\n", color.CyanString(p.Suggestion))
+	}
+}
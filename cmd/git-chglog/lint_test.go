@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	chglog "github.com/git-chglog/git-chglog"
+)
+
+func TestLintCLI(t *testing.T) {
+	assert := assert.New(t)
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	configLoader := &mockConfigLoaderImpl{
+		ReturnLoad: func(path string) (*Config, error) {
+			return &Config{
+				Options: Options{
+					Lint: LintOptions{
+						Types:  []string{"feat", "fix"},
+						Scopes: []string{"core"},
+					},
+				},
+			}, nil
+		},
+	}
+
+	var problems []*chglog.LintProblem
+	linter := &mockLinterImpl{
+		ReturnLint: func(rev string, config *chglog.Config) ([]*chglog.LintProblem, error) {
+			if rev != "main..HEAD" {
+				return nil, errors.New("unexpected range")
+			}
+			assert.Equal([]string{"feat", "fix"}, config.Options.LintTypes)
+			assert.Equal([]string{"core"}, config.Options.LintScopes)
+			return problems, nil
+		},
+	}
+
+	newLintCLI := func() *LintCLI {
+		stdout.Reset()
+		stderr.Reset()
+		return NewLintCLI(
+			&CLIContext{
+				WorkingDir: "/",
+				ConfigPath: "/.chglog/config.yml",
+				Stdout:     stdout,
+				Stderr:     stderr,
+				NoColor:    true,
+				NoEmoji:    true,
+				Query:      "main..HEAD",
+			},
+			configLoader,
+			linter,
+		)
+	}
+
+	// without problems
+	assert.Equal(ExitCodeOK, newLintCLI().Run())
+	assert.Contains(stdout.String(), "No problem found in \"main..HEAD\"")
+	assert.Equal("", stderr.String())
+
+	// with problems
+	problems = []*chglog.LintProblem{
+		{
+			Hash:    &chglog.Hash{Short: "abc1234"},
+			Header:  "Feat(core): Add feature",
+			Message: "type \"Feat\" is not allowed, use one of: feat, fix",
+		},
+	}
+
+	assert.Equal(ExitCodeError, newLintCLI().Run())
+	assert.Equal("abc1234 Feat(core): Add feature: type \"Feat\" is not allowed, use one of: feat, fix\n", stdout.String())
+	assert.Contains(stderr.String(), "1 problem(s) found in \"main..HEAD\"")
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	chglog "github.com/git-chglog/git-chglog"
+)
+
+// Linter ...
+type Linter interface {
+	Lint(*chglog.Logger, string, *chglog.Config) ([]*chglog.LintProblem, error)
+	LintMessage(*chglog.Logger, string, *chglog.Config) []*chglog.LintProblem
+}
+
+type linterImpl struct{}
+
+// NewLinter ...
+func NewLinter() Linter {
+	return &linterImpl{}
+}
+
+// Lint ...
+func (*linterImpl) Lint(logger *chglog.Logger, rev string, config *chglog.Config) ([]*chglog.LintProblem, error) {
+	return chglog.NewLinter(logger, config).Lint(rev)
+}
+
+// LintMessage ...
+func (*linterImpl) LintMessage(logger *chglog.Logger, message string, config *chglog.Config) []*chglog.LintProblem {
+	return chglog.NewLinter(logger, config).LintMessage(message)
+}
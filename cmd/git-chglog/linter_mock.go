@@ -0,0 +1,18 @@
+package main
+
+import (
+	chglog "github.com/git-chglog/git-chglog"
+)
+
+type mockLinterImpl struct {
+	ReturnLint        func(string, *chglog.Config) ([]*chglog.LintProblem, error)
+	ReturnLintMessage func(string, *chglog.Config) []*chglog.LintProblem
+}
+
+func (m *mockLinterImpl) Lint(logger *chglog.Logger, rev string, config *chglog.Config) ([]*chglog.LintProblem, error) {
+	return m.ReturnLint(rev, config)
+}
+
+func (m *mockLinterImpl) LintMessage(logger *chglog.Logger, message string, config *chglog.Config) []*chglog.LintProblem {
+	return m.ReturnLintMessage(message, config)
+}
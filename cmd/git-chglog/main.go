@@ -31,6 +31,16 @@ func CreateApp(actionFunc cli.ActionFunc) *cli.App {
     3. ..<name>     - Commit from the oldest tag to <name>.
     4. <name>       - Commit contained in <name>.
 
+  {{.Name}} lint [options] <range>
+
+    Reports the commits of <range> (e.g. origin/main..HEAD, all commits if not specified)
+    whose header does not match "header.pattern", or whose type or scope is not allowed.
+
+  {{.Name}} hook install [options]
+
+    Writes a commit-msg hook validating every new commit message like "lint",
+    and the keywords of the notes, before the commit is created.
+
 %s
   {{range .Flags}}{{.}}
   {{end}}
@@ -125,6 +135,23 @@ func CreateApp(actionFunc cli.ActionFunc) *cli.App {
 			Usage: "treat unreleased commits as specified tags (EXPERIMENTAL)",
 		},
 
+		&cli.StringFlag{
+			Name:  "next-tag-ref",
+			Usage: "commit at which the next tag is cut, later commits remain unreleased. Only with --next-tag",
+		},
+
+		// fetch-tags
+		&cli.BoolFlag{
+			Name:  "fetch-tags",
+			Usage: "fetch tags from the remote before generating (e.g. for CI clones without tags)",
+		},
+
+		// unshallow
+		&cli.BoolFlag{
+			Name:  "unshallow",
+			Usage: "fetch the complete history and tags if the repository is a shallow clone",
+		},
+
 		// silent
 		&cli.BoolFlag{
 			Name:  "silent",
@@ -151,6 +178,12 @@ func CreateApp(actionFunc cli.ActionFunc) *cli.App {
 			Usage: "disable case sensitive filters",
 		},
 
+		// strict
+		&cli.BoolFlag{
+			Name:  "strict",
+			Usage: "fail without writing the CHANGELOG if a Jira or issue lookup fails, a header matches no pattern or a tag of the query does not exist",
+		},
+
 		// tag-filter-pattern
 		&cli.StringFlag{
 			Name:  "tag-filter-pattern",
@@ -178,6 +211,27 @@ func CreateApp(actionFunc cli.ActionFunc) *cli.App {
 			EnvVars: []string{"JIRA_TOKEN"},
 		},
 
+		// jira-auth-type
+		&cli.StringFlag{
+			Name:    "jira-auth-type",
+			Usage:   "Jira auth type (basic, bearer or oauth1)",
+			EnvVars: []string{"JIRA_AUTH_TYPE"},
+		},
+
+		// jira-export
+		&cli.StringFlag{
+			Name:    "jira-export",
+			Usage:   "JSON or CSV export of Jira issues, or a directory of issue JSON files, read instead of requesting Jira",
+			EnvVars: []string{"JIRA_EXPORT"},
+		},
+
+		// issue-tracker-token
+		&cli.StringFlag{
+			Name:    "issue-tracker-token",
+			Usage:   "Token of the issue tracker",
+			EnvVars: []string{"ISSUE_TRACKER_TOKEN"},
+		},
+
 		// sort
 		&cli.StringFlag{
 			Name:        "sort",
@@ -190,6 +244,106 @@ func CreateApp(actionFunc cli.ActionFunc) *cli.App {
 		cli.VersionFlag,
 	}
 
+	app.Commands = []*cli.Command{
+		{
+			Name:      "lint",
+			Usage:     "report the commits breaking the header pattern, allowed types or scopes of the configuration",
+			ArgsUsage: "<range>",
+			Flags: []cli.Flag{
+				&cli.StringSliceFlag{
+					Name:  "path",
+					Usage: "Filter commits by path(s). Can use multiple times.",
+				},
+				&cli.StringFlag{
+					Name:    "config",
+					Aliases: []string{"c"},
+					Usage:   "specifies a different configuration file to pick up",
+					Value:   ".chglog/config.yml",
+				},
+				&cli.BoolFlag{
+					Name:  "silent",
+					Usage: "disable stdout output except for the problems",
+				},
+				&cli.BoolFlag{
+					Name:    "no-color",
+					Usage:   "disable color output",
+					EnvVars: []string{"NO_COLOR"},
+				},
+				&cli.BoolFlag{
+					Name:    "no-emoji",
+					Usage:   "disable emoji output",
+					EnvVars: []string{"NO_EMOJI"},
+				},
+				&cli.BoolFlag{
+					Name:  "no-case",
+					Usage: "disable case sensitive types and scopes",
+				},
+			},
+			Action: LintAction,
+		},
+		{
+			Name:  "hook",
+			Usage: "manage the git hooks of git-chglog",
+			Subcommands: []*cli.Command{
+				{
+					Name:  "install",
+					Usage: "write a commit-msg hook validating the commit messages",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:    "config",
+							Aliases: []string{"c"},
+							Usage:   "configuration file used by the hook, relative to the repository root",
+							Value:   ".chglog/config.yml",
+						},
+						&cli.BoolFlag{
+							Name:  "force",
+							Usage: "overwrite a commit-msg hook not installed by git-chglog",
+						},
+						&cli.BoolFlag{
+							Name:    "no-color",
+							Usage:   "disable color output",
+							EnvVars: []string{"NO_COLOR"},
+						},
+						&cli.BoolFlag{
+							Name:    "no-emoji",
+							Usage:   "disable emoji output",
+							EnvVars: []string{"NO_EMOJI"},
+						},
+					},
+					Action: HookInstallAction,
+				},
+				{
+					Name:      "commit-msg",
+					Usage:     "validate a commit message file, run by the installed hook",
+					ArgsUsage: "<message file>",
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:    "config",
+							Aliases: []string{"c"},
+							Usage:   "specifies a different configuration file to pick up",
+							Value:   ".chglog/config.yml",
+						},
+						&cli.BoolFlag{
+							Name:    "no-color",
+							Usage:   "disable color output",
+							EnvVars: []string{"NO_COLOR"},
+						},
+						&cli.BoolFlag{
+							Name:    "no-emoji",
+							Usage:   "disable emoji output",
+							EnvVars: []string{"NO_EMOJI"},
+						},
+						&cli.BoolFlag{
+							Name:  "no-case",
+							Usage: "disable case sensitive types and scopes",
+						},
+					},
+					Action: HookCommitMsgAction,
+				},
+			},
+		},
+	}
+
 	app.Action = actionFunc
 
 	return app
@@ -240,12 +394,19 @@ func AppAction(c *cli.Context) error {
 			NoColor:          c.Bool("no-color"),
 			NoEmoji:          c.Bool("no-emoji"),
 			NoCaseSensitive:  c.Bool("no-case"),
+			Strict:           c.Bool("strict"),
 			Query:            c.Args().First(),
 			NextTag:          c.String("next-tag"),
+			NextTagRef:       c.String("next-tag-ref"),
+			FetchTags:        c.Bool("fetch-tags"),
+			Unshallow:        c.Bool("unshallow"),
 			TagFilterPattern: c.String("tag-filter-pattern"),
 			JiraUsername:     c.String("jira-username"),
 			JiraToken:        c.String("jira-token"),
 			JiraURL:          c.String("jira-url"),
+			JiraAuthType:     c.String("jira-auth-type"),
+			JiraExport:       c.String("jira-export"),
+			TrackerToken:     c.String("issue-tracker-token"),
 			Paths:            c.StringSlice("path"),
 			Sort:             c.String("sort"),
 		},
@@ -259,6 +420,104 @@ func AppAction(c *cli.Context) error {
 	return nil
 }
 
+// LintAction is a callback function to run the lint command.
+func LintAction(c *cli.Context) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to get working directory", err)
+		os.Exit(ExitCodeError)
+	}
+
+	rev := c.Args().First()
+	if rev == "" {
+		rev = "HEAD"
+	}
+
+	lintCLI := NewLintCLI(
+		&CLIContext{
+			WorkingDir:      wd,
+			Stdout:          colorable.NewColorableStdout(),
+			Stderr:          colorable.NewColorableStderr(),
+			ConfigPath:      c.String("config"),
+			Silent:          c.Bool("silent"),
+			NoColor:         c.Bool("no-color"),
+			NoEmoji:         c.Bool("no-emoji"),
+			NoCaseSensitive: c.Bool("no-case"),
+			Query:           rev,
+			Paths:           c.StringSlice("path"),
+		},
+		NewConfigLoader(),
+		NewLinter(),
+	)
+
+	os.Exit(lintCLI.Run())
+
+	return nil
+}
+
+// HookInstallAction is a callback function to install the commit-msg hook.
+func HookInstallAction(c *cli.Context) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to get working directory", err)
+		os.Exit(ExitCodeError)
+	}
+
+	installer := NewHookInstaller(
+		&HookContext{
+			WorkingDir: wd,
+			Stdout:     colorable.NewColorableStdout(),
+			Stderr:     colorable.NewColorableStderr(),
+			ConfigPath: c.String("config"),
+			NoColor:    c.Bool("no-color"),
+			NoEmoji:    c.Bool("no-emoji"),
+			Force:      c.Bool("force"),
+		},
+		fs,
+		gitcmd.New(&gitcmd.Config{
+			Bin: "git",
+		}),
+	)
+
+	os.Exit(installer.Run())
+
+	return nil
+}
+
+// HookCommitMsgAction is a callback function to validate the message file of the commit-msg hook.
+func HookCommitMsgAction(c *cli.Context) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "failed to get working directory", err)
+		os.Exit(ExitCodeError)
+	}
+
+	if c.Args().First() == "" {
+		fmt.Fprintln(os.Stderr, "the commit message file is required")
+		os.Exit(ExitCodeError)
+	}
+
+	commitMsgCLI := NewCommitMsgCLI(
+		&HookContext{
+			WorkingDir:      wd,
+			Stdout:          colorable.NewColorableStdout(),
+			Stderr:          colorable.NewColorableStderr(),
+			ConfigPath:      c.String("config"),
+			NoColor:         c.Bool("no-color"),
+			NoEmoji:         c.Bool("no-emoji"),
+			NoCaseSensitive: c.Bool("no-case"),
+			MessageFile:     c.Args().First(),
+		},
+		fs,
+		NewConfigLoader(),
+		NewLinter(),
+	)
+
+	os.Exit(commitMsgCLI.Run())
+
+	return nil
+}
+
 func main() {
 	app := CreateApp(AppAction)
 	err := app.Run(os.Args)
@@ -2,7 +2,8 @@ package main
 
 import (
 	"fmt"
-	"net/url"
+	"regexp"
+	"strings"
 
 	chglog "github.com/git-chglog/git-chglog"
 )
@@ -19,18 +20,69 @@ func NewProcessorFactory() *ProcessorFactory {
 			"github":    "github.com",
 			"gitlab":    "gitlab.com",
 			"bitbucket": "bitbucket.org",
+			"gitea":     "gitea.com",
+			"azure":     "dev.azure.com",
 		},
 	}
 }
 
-// Create ...
-func (factory *ProcessorFactory) Create(config *Config) (chglog.Processor, error) {
-	obj, err := url.Parse(config.Info.RepositoryURL)
+// CreateChain creates the built-in processors enabled by `options.processors` in order.
+// If not specified, the processor of the detected style is followed by the `links` one.
+func (factory *ProcessorFactory) CreateChain(config *Config) ([]chglog.Processor, error) {
+	base, host, err := factory.resolve(config)
 	if err != nil {
 		return nil, err
 	}
 
+	names := config.Options.Processors
+	if len(names) == 0 {
+		names = []string{"", "links"}
+	}
+
+	processors := []chglog.Processor{}
+
+	for i, name := range names {
+		var processor chglog.Processor
+
+		switch name = strings.ToLower(name); {
+		case name == "":
+			processor = factory.createStyleProcessor(host, base)
+		case name == "links":
+			processor, err = factory.createLinkProcessor(config, base)
+			if err != nil {
+				return nil, err
+			}
+		case factory.hostRegistry[name] != "":
+			processor = factory.createStyleProcessor(factory.hostRegistry[name], base)
+		default:
+			return nil, fmt.Errorf("\"%s\" of processors[%d] is not a built-in processor", name, i)
+		}
+
+		if processor != nil {
+			processors = append(processors, processor)
+		}
+	}
+
+	return processors, nil
+}
+
+// resolve returns the base URL of the forge and the host used to choose its processor
+func (factory *ProcessorFactory) resolve(config *Config) (string, string, error) {
+	obj, err := parseRepositoryURL(config.Info.RepositoryURL)
+	if err != nil {
+		return "", "", err
+	}
+
 	host := obj.Host
+	base := fmt.Sprintf("%s://%s", obj.Scheme, obj.Host)
+
+	// self-hosted instances, possibly installed under a sub-path
+	if prefix, style, ok := lookupHost(config.Hosts, obj); ok {
+		base = fmt.Sprintf("%s://%s", obj.Scheme, prefix)
+		if styleHost, ok := factory.hostRegistry[style]; ok {
+			host = styleHost
+		}
+	}
 
 	if config.Style != "" {
 		if styleHost, ok := factory.hostRegistry[config.Style]; ok {
@@ -38,20 +90,58 @@ func (factory *ProcessorFactory) Create(config *Config) (chglog.Processor, error
 		}
 	}
 
+	return base, host, nil
+}
+
+func (*ProcessorFactory) createStyleProcessor(host string, base string) chglog.Processor {
 	switch host {
 	case "github.com":
 		return &chglog.GitHubProcessor{
-			Host: fmt.Sprintf("%s://%s", obj.Scheme, obj.Host),
-		}, nil
+			Host: base,
+		}
 	case "gitlab.com":
 		return &chglog.GitLabProcessor{
-			Host: fmt.Sprintf("%s://%s", obj.Scheme, obj.Host),
-		}, nil
+			Host: base,
+		}
 	case "bitbucket.org":
 		return &chglog.BitbucketProcessor{
-			Host: fmt.Sprintf("%s://%s", obj.Scheme, obj.Host),
-		}, nil
+			Host: base,
+		}
+	case "gitea.com", "codeberg.org":
+		return &chglog.GiteaProcessor{
+			Host: base,
+		}
+	case "dev.azure.com":
+		return &chglog.AzureDevOpsProcessor{
+			Host: base,
+		}
 	default:
+		return nil
+	}
+}
+
+// createLinkProcessor creates a processor from the `links` rules for hosts without a dedicated processor
+func (factory *ProcessorFactory) createLinkProcessor(config *Config, base string) (chglog.Processor, error) {
+	links := config.Options.Links
+	if len(links) == 0 {
 		return nil, nil
 	}
+
+	rules := make([]chglog.LinkRule, len(links))
+	for i, link := range links {
+		if _, err := regexp.Compile(link.Pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern of links[%d]: %w", i, err)
+		}
+
+		rules[i] = chglog.LinkRule{
+			Pattern: link.Pattern,
+			URL:     link.URL,
+			Text:    link.Text,
+		}
+	}
+
+	return &chglog.LinkProcessor{
+		Host:  base,
+		Rules: rules,
+	}, nil
 }
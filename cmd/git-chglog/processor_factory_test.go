@@ -12,14 +12,14 @@ func TestProcessorFactory(t *testing.T) {
 	assert := assert.New(t)
 	factory := NewProcessorFactory()
 
-	processor, err := factory.Create(&Config{
+	processors, err := factory.CreateChain(&Config{
 		Info: Info{
 			RepositoryURL: "https://example.com/owner/repo",
 		},
 	})
 
 	assert.Nil(err)
-	assert.Nil(processor)
+	assert.Empty(processors)
 }
 
 func TestProcessorFactoryForGitHub(t *testing.T) {
@@ -27,7 +27,7 @@ func TestProcessorFactoryForGitHub(t *testing.T) {
 	factory := NewProcessorFactory()
 
 	// github.com
-	processor, err := factory.Create(&Config{
+	processors, err := factory.CreateChain(&Config{
 		Info: Info{
 			RepositoryURL: "https://github.com/owner/repo",
 		},
@@ -35,14 +35,16 @@ func TestProcessorFactoryForGitHub(t *testing.T) {
 
 	assert.Nil(err)
 	assert.Equal(
-		&chglog.GitHubProcessor{
-			Host: "https://github.com",
+		[]chglog.Processor{
+			&chglog.GitHubProcessor{
+				Host: "https://github.com",
+			},
 		},
-		processor,
+		processors,
 	)
 
 	// ghe
-	processor, err = factory.Create(&Config{
+	processors, err = factory.CreateChain(&Config{
 		Style: "github",
 		Info: Info{
 			RepositoryURL: "https://ghe-example.com/owner/repo",
@@ -51,10 +53,12 @@ func TestProcessorFactoryForGitHub(t *testing.T) {
 
 	assert.Nil(err)
 	assert.Equal(
-		&chglog.GitHubProcessor{
-			Host: "https://ghe-example.com",
+		[]chglog.Processor{
+			&chglog.GitHubProcessor{
+				Host: "https://ghe-example.com",
+			},
 		},
-		processor,
+		processors,
 	)
 }
 
@@ -63,7 +67,7 @@ func TestProcessorFactoryForGitLab(t *testing.T) {
 	factory := NewProcessorFactory()
 
 	// gitlab.com
-	processor, err := factory.Create(&Config{
+	processors, err := factory.CreateChain(&Config{
 		Info: Info{
 			RepositoryURL: "https://gitlab.com/owner/repo",
 		},
@@ -71,14 +75,16 @@ func TestProcessorFactoryForGitLab(t *testing.T) {
 
 	assert.Nil(err)
 	assert.Equal(
-		&chglog.GitLabProcessor{
-			Host: "https://gitlab.com",
+		[]chglog.Processor{
+			&chglog.GitLabProcessor{
+				Host: "https://gitlab.com",
+			},
 		},
-		processor,
+		processors,
 	)
 
 	// self-hosted
-	processor, err = factory.Create(&Config{
+	processors, err = factory.CreateChain(&Config{
 		Style: "gitlab",
 		Info: Info{
 			RepositoryURL: "https://original-gitserver.com/owner/repo",
@@ -87,10 +93,12 @@ func TestProcessorFactoryForGitLab(t *testing.T) {
 
 	assert.Nil(err)
 	assert.Equal(
-		&chglog.GitLabProcessor{
-			Host: "https://original-gitserver.com",
+		[]chglog.Processor{
+			&chglog.GitLabProcessor{
+				Host: "https://original-gitserver.com",
+			},
 		},
-		processor,
+		processors,
 	)
 }
 
@@ -99,7 +107,7 @@ func TestProcessorFactoryForBitbucket(t *testing.T) {
 	factory := NewProcessorFactory()
 
 	// bitbucket.org
-	processor, err := factory.Create(&Config{
+	processors, err := factory.CreateChain(&Config{
 		Info: Info{
 			RepositoryURL: "https://bitbucket.org/owner/repo",
 		},
@@ -107,14 +115,16 @@ func TestProcessorFactoryForBitbucket(t *testing.T) {
 
 	assert.Nil(err)
 	assert.Equal(
-		&chglog.BitbucketProcessor{
-			Host: "https://bitbucket.org",
+		[]chglog.Processor{
+			&chglog.BitbucketProcessor{
+				Host: "https://bitbucket.org",
+			},
 		},
-		processor,
+		processors,
 	)
 
 	// self-hosted
-	processor, err = factory.Create(&Config{
+	processors, err = factory.CreateChain(&Config{
 		Style: "bitbucket",
 		Info: Info{
 			RepositoryURL: "https://original-gitserver.com/owner/repo",
@@ -123,9 +133,287 @@ func TestProcessorFactoryForBitbucket(t *testing.T) {
 
 	assert.Nil(err)
 	assert.Equal(
-		&chglog.BitbucketProcessor{
-			Host: "https://original-gitserver.com",
+		[]chglog.Processor{
+			&chglog.BitbucketProcessor{
+				Host: "https://original-gitserver.com",
+			},
+		},
+		processors,
+	)
+}
+
+func TestProcessorFactoryForGitea(t *testing.T) {
+	assert := assert.New(t)
+	factory := NewProcessorFactory()
+
+	// gitea.com
+	processors, err := factory.CreateChain(&Config{
+		Info: Info{
+			RepositoryURL: "https://gitea.com/owner/repo",
+		},
+	})
+
+	assert.Nil(err)
+	assert.Equal(
+		[]chglog.Processor{
+			&chglog.GiteaProcessor{
+				Host: "https://gitea.com",
+			},
+		},
+		processors,
+	)
+
+	// codeberg.org (forgejo)
+	processors, err = factory.CreateChain(&Config{
+		Info: Info{
+			RepositoryURL: "https://codeberg.org/owner/repo",
+		},
+	})
+
+	assert.Nil(err)
+	assert.Equal(
+		[]chglog.Processor{
+			&chglog.GiteaProcessor{
+				Host: "https://codeberg.org",
+			},
+		},
+		processors,
+	)
+
+	// self-hosted
+	processors, err = factory.CreateChain(&Config{
+		Style: "gitea",
+		Info: Info{
+			RepositoryURL: "https://original-gitserver.com/owner/repo",
+		},
+	})
+
+	assert.Nil(err)
+	assert.Equal(
+		[]chglog.Processor{
+			&chglog.GiteaProcessor{
+				Host: "https://original-gitserver.com",
+			},
+		},
+		processors,
+	)
+}
+
+func TestProcessorFactoryForAzureDevOps(t *testing.T) {
+	assert := assert.New(t)
+	factory := NewProcessorFactory()
+
+	// dev.azure.com
+	processors, err := factory.CreateChain(&Config{
+		Info: Info{
+			RepositoryURL: "https://dev.azure.com/org/project/_git/repo",
+		},
+	})
+
+	assert.Nil(err)
+	assert.Equal(
+		[]chglog.Processor{
+			&chglog.AzureDevOpsProcessor{
+				Host: "https://dev.azure.com",
+			},
+		},
+		processors,
+	)
+
+	// azure devops server
+	processors, err = factory.CreateChain(&Config{
+		Style: "azure",
+		Info: Info{
+			RepositoryURL: "https://tfs.example.com/org/project/_git/repo",
+		},
+	})
+
+	assert.Nil(err)
+	assert.Equal(
+		[]chglog.Processor{
+			&chglog.AzureDevOpsProcessor{
+				Host: "https://tfs.example.com",
+			},
+		},
+		processors,
+	)
+}
+
+func TestProcessorFactoryForHosts(t *testing.T) {
+	assert := assert.New(t)
+	factory := NewProcessorFactory()
+
+	hosts := map[string]string{
+		"github.mycorp.com":     "github",
+		"git.mycorp.com/gitlab": "gitlab",
+	}
+
+	// github enterprise
+	processors, err := factory.CreateChain(&Config{
+		Hosts: hosts,
+		Info: Info{
+			RepositoryURL: "https://github.mycorp.com/owner/repo",
+		},
+	})
+
+	assert.Nil(err)
+	assert.Equal(
+		[]chglog.Processor{
+			&chglog.GitHubProcessor{
+				Host: "https://github.mycorp.com",
+			},
 		},
-		processor,
+		processors,
 	)
+
+	// gitlab installed under a sub-path
+	processors, err = factory.CreateChain(&Config{
+		Hosts: hosts,
+		Info: Info{
+			RepositoryURL: "git@git.mycorp.com:gitlab/group/repo.git",
+		},
+	})
+
+	assert.Nil(err)
+	assert.Equal(
+		[]chglog.Processor{
+			&chglog.GitLabProcessor{
+				Host: "https://git.mycorp.com/gitlab",
+			},
+		},
+		processors,
+	)
+
+	// not mapped
+	processors, err = factory.CreateChain(&Config{
+		Hosts: hosts,
+		Info: Info{
+			RepositoryURL: "https://git.mycorp.com/owner/repo",
+		},
+	})
+
+	assert.Nil(err)
+	assert.Empty(processors)
+}
+
+func TestProcessorFactoryForLinks(t *testing.T) {
+	assert := assert.New(t)
+	factory := NewProcessorFactory()
+
+	processors, err := factory.CreateChain(&Config{
+		Info: Info{
+			RepositoryURL: "https://gitea.example.com/owner/repo",
+		},
+		Options: Options{
+			Links: []LinkOptions{
+				{Pattern: "#(\\d+)", URL: "{repository_url}/issues/$1"},
+				{Pattern: "PROJ-(\\d+)", URL: "https://tracker.example.com/browse/PROJ-$1", Text: "PROJ-$1"},
+			},
+		},
+	})
+
+	assert.Nil(err)
+	assert.Equal(
+		[]chglog.Processor{
+			&chglog.LinkProcessor{
+				Host: "https://gitea.example.com",
+				Rules: []chglog.LinkRule{
+					{Pattern: "#(\\d+)", URL: "{repository_url}/issues/$1"},
+					{Pattern: "PROJ-(\\d+)", URL: "https://tracker.example.com/browse/PROJ-$1", Text: "PROJ-$1"},
+				},
+			},
+		},
+		processors,
+	)
+
+	// invalid pattern
+	processors, err = factory.CreateChain(&Config{
+		Info: Info{
+			RepositoryURL: "https://gitea.example.com/owner/repo",
+		},
+		Options: Options{
+			Links: []LinkOptions{
+				{Pattern: "(", URL: "https://example.com"},
+			},
+		},
+	})
+
+	assert.Error(err)
+	assert.Empty(processors)
+}
+
+func TestProcessorFactoryCreateChain(t *testing.T) {
+	assert := assert.New(t)
+	factory := NewProcessorFactory()
+
+	links := []LinkOptions{
+		{Pattern: "PROJ-(\\d+)", URL: "https://tracker.example.com/browse/PROJ-$1"},
+	}
+
+	// detected style followed by links
+	processors, err := factory.CreateChain(&Config{
+		Info: Info{
+			RepositoryURL: "https://github.com/owner/repo",
+		},
+		Options: Options{
+			Links: links,
+		},
+	})
+
+	assert.Nil(err)
+	assert.Equal(
+		[]chglog.Processor{
+			&chglog.GitHubProcessor{
+				Host: "https://github.com",
+			},
+			&chglog.LinkProcessor{
+				Host: "https://github.com",
+				Rules: []chglog.LinkRule{
+					{Pattern: "PROJ-(\\d+)", URL: "https://tracker.example.com/browse/PROJ-$1"},
+				},
+			},
+		},
+		processors,
+	)
+
+	// enabled by name in order
+	processors, err = factory.CreateChain(&Config{
+		Info: Info{
+			RepositoryURL: "https://git.example.com/owner/repo",
+		},
+		Options: Options{
+			Links:      links,
+			Processors: []string{"links", "GitLab"},
+		},
+	})
+
+	assert.Nil(err)
+	assert.Len(processors, 2)
+	assert.IsType(&chglog.LinkProcessor{}, processors[0])
+	assert.Equal(
+		&chglog.GitLabProcessor{
+			Host: "https://git.example.com",
+		},
+		processors[1],
+	)
+
+	// nothing to enable
+	processors, err = factory.CreateChain(&Config{
+		Info: Info{
+			RepositoryURL: "https://git.example.com/owner/repo",
+		},
+	})
+
+	assert.Nil(err)
+	assert.Empty(processors)
+
+	// unknown name
+	processors, err = factory.CreateChain(&Config{
+		Options: Options{
+			Processors: []string{"github", "unknown"},
+		},
+	})
+
+	assert.EqualError(err, "\"unknown\" of processors[1] is not a built-in processor")
+	assert.Nil(processors)
 }
@@ -4,4 +4,5 @@ package main
 const (
 	ExitCodeOK = iota
 	ExitCodeError
+	ExitCodeStrict // Problems found in strict mode
 )
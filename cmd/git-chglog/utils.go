@@ -7,7 +7,10 @@ import (
 	"strings"
 )
 
-var reSSH = regexp.MustCompile(`^\w+@([\w\.\-]+):([\w\.\-]+)\/([\w\.\-]+)$`)
+var (
+	reSSH    = regexp.MustCompile(`^\w+@([\w\.\-]+):([\w\.\-]+)\/([\w\.\-]+)$`)
+	reSCPURL = regexp.MustCompile(`^[\w\.\-]+@([\w\.\-]+):`)
+)
 
 func remoteOriginURLToHTTP(rawurl string) string {
 	if rawurl == "" {
@@ -45,3 +48,68 @@ func remoteOriginURLToHTTP(rawurl string) string {
 
 	return ""
 }
+
+// parseRepositoryURL parses the repository URL as a web URL.
+// URLs without scheme, SSH and scp-like (`git@host:owner/repo`) URLs are handled as https.
+func parseRepositoryURL(rawurl string) (*url.URL, error) {
+	if rawurl == "" {
+		return &url.URL{}, nil
+	}
+
+	if !strings.Contains(rawurl, "://") {
+		rawurl = "https://" + reSCPURL.ReplaceAllString(rawurl, "$1/")
+	}
+
+	obj, err := url.Parse(strings.TrimSuffix(rawurl, ".git"))
+	if err != nil {
+		return nil, err
+	}
+
+	if obj.Scheme != "http" && obj.Scheme != "https" {
+		// the port of SSH is not the one of the web interface
+		obj.Scheme = "https"
+		obj.Host = obj.Hostname()
+	}
+	obj.User = nil
+
+	return obj, nil
+}
+
+// lookupHost finds the entry of hosts matching the repository URL and returns the
+// base of the forge (host and the path it is installed under) with its style.
+// A key may contain a path (e.g. `git.example.com/gitlab`), the longest match wins.
+func lookupHost(hosts map[string]string, obj *url.URL) (base string, style string, ok bool) {
+	matched := -1
+	path := strings.ToLower(obj.Path) + "/"
+
+	for key, value := range hosts {
+		if i := strings.Index(key, "://"); i > -1 {
+			key = key[i+3:]
+		}
+
+		keyHost, keyPath := key, ""
+		if i := strings.Index(key, "/"); i > -1 {
+			keyHost, keyPath = key[:i], strings.Trim(key[i:], "/")
+		}
+
+		if !strings.EqualFold(keyHost, obj.Host) && !strings.EqualFold(keyHost, obj.Hostname()) {
+			continue
+		}
+
+		if keyPath != "" && !strings.HasPrefix(path, "/"+strings.ToLower(keyPath)+"/") {
+			continue
+		}
+
+		if len(keyPath) > matched {
+			matched = len(keyPath)
+			base = obj.Host
+			if keyPath != "" {
+				base += obj.Path[:len(keyPath)+1]
+			}
+			style = strings.ToLower(value)
+			ok = true
+		}
+	}
+
+	return base, style, ok
+}
@@ -21,3 +21,55 @@ func TestRemoteOriginURLToHTTP(t *testing.T) {
 		assert.Equal(v[1], remoteOriginURLToHTTP(v[0]))
 	}
 }
+
+func TestParseRepositoryURL(t *testing.T) {
+	assert := assert.New(t)
+
+	table := [][]string{
+		{"https://github.com/owner0/repo0", "https://github.com/owner0/repo0"},
+		{"http://git.example.com:8080/owner1/repo1", "http://git.example.com:8080/owner1/repo1"},
+		{"git@git.example.com:group/sub/repo2.git", "https://git.example.com/group/sub/repo2"},
+		{"ssh://git@git.example.com:2222/owner3/repo3.git", "https://git.example.com/owner3/repo3"},
+		{"git.example.com/gitlab/owner4/repo4", "https://git.example.com/gitlab/owner4/repo4"},
+		{"", ""},
+	}
+
+	for _, v := range table {
+		obj, err := parseRepositoryURL(v[0])
+		assert.Nil(err)
+		assert.Equal(v[1], obj.String())
+	}
+}
+
+func TestLookupHost(t *testing.T) {
+	assert := assert.New(t)
+
+	hosts := map[string]string{
+		"git.example.com":          "GitHub",
+		"git.example.com/gitlab":   "gitlab",
+		"https://code.example.com": "gitea",
+	}
+
+	table := []struct {
+		url   string
+		base  string
+		style string
+		ok    bool
+	}{
+		{"https://git.example.com/owner/repo", "git.example.com", "github", true},
+		{"https://git.example.com/GitLab/group/repo", "git.example.com/GitLab", "gitlab", true},
+		{"https://git.example.com/gitlab-ce/repo", "git.example.com", "github", true},
+		{"https://code.example.com:3000/owner/repo", "code.example.com:3000", "gitea", true},
+		{"https://github.com/owner/repo", "", "", false},
+	}
+
+	for _, v := range table {
+		obj, err := parseRepositoryURL(v.url)
+		assert.Nil(err)
+
+		base, style, ok := lookupHost(hosts, obj)
+		assert.Equal(v.base, base, v.url)
+		assert.Equal(v.style, style, v.url)
+		assert.Equal(v.ok, ok, v.url)
+	}
+}
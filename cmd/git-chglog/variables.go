@@ -23,11 +23,15 @@ var (
 	styleGitHub    = "github"
 	styleGitLab    = "gitlab"
 	styleBitbucket = "bitbucket"
+	styleGitea     = "gitea"
+	styleAzure     = "azure"
 	styleNone      = "none"
 	styles         = []string{
 		styleGitHub,
 		styleGitLab,
 		styleBitbucket,
+		styleGitea,
+		styleAzure,
 		styleNone,
 	}
 )
@@ -114,6 +114,11 @@ func (e *commitExtractor) commitGroupTitle(commit *Commit) (string, string) {
 	)
 
 	if title, ok := dotGet(commit, e.opts.CommitGroupBy); ok {
+		// multi-valued Jira fields (e.g. components) are grouped by their combination
+		if values, ok := title.([]string); ok {
+			title = strings.Join(values, ", ")
+		}
+
 		if v, ok := title.(string); ok {
 			raw = v
 			if t, ok := e.opts.CommitGroupTitleMaps[v]; ok {
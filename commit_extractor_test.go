@@ -266,3 +266,31 @@ func TestCommitOrderExtractor(t *testing.T) {
 		},
 	}, noteGroups)
 }
+
+func TestCommitExtractorSortByJiraFieldList(t *testing.T) {
+	assert := assert.New(t)
+
+	extractor := newCommitExtractor(&Options{
+		CommitSortBy:      "JiraIssue.Fields.Components",
+		CommitGroupBy:     "Type",
+		CommitGroupSortBy: "Title",
+	})
+
+	newCommit := func(header string, components ...string) *Commit {
+		return &Commit{
+			Type:      "feat",
+			Header:    header,
+			JiraIssue: &JiraIssue{Fields: map[string]interface{}{"Components": components}},
+		}
+	}
+
+	fixtures := []*Commit{
+		newCommit("1", "web"),
+		newCommit("2", "api", "web"),
+		newCommit("3", "api"),
+	}
+
+	commitGroups, _, _, _ := extractor.Extract(fixtures)
+
+	assert.Equal([]*Commit{fixtures[2], fixtures[1], fixtures[0]}, commitGroups[0].Commits)
+}
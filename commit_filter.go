@@ -25,25 +25,24 @@ func commitFilter(commits []*Commit, filters map[string][]string, noCaseSensitiv
 				break
 			}
 
-			str, ok := prop.(string)
+			props, ok := filterValues(prop)
 			if !ok {
 				include = false
 				break
 			}
 
-			if noCaseSensitive {
-				str = strings.ToLower(str)
-			}
-
 			exist := false
 
-			for _, val := range values {
-				if noCaseSensitive {
-					val = strings.ToLower(val)
-				}
+			for _, str := range props {
+				for _, val := range values {
+					if noCaseSensitive {
+						str = strings.ToLower(str)
+						val = strings.ToLower(val)
+					}
 
-				if str == val {
-					exist = true
+					if str == val {
+						exist = true
+					}
 				}
 			}
 
@@ -62,3 +61,16 @@ func commitFilter(commits []*Commit, filters map[string][]string, noCaseSensitiv
 
 	return res
 }
+
+// filterValues returns the values of a property to filter, a multi-valued property (e.g. fix versions of Jira)
+// matches if one of them does
+func filterValues(prop interface{}) ([]string, bool) {
+	switch v := prop.(type) {
+	case string:
+		return []string{v}, true
+	case []string:
+		return v, true
+	default:
+		return nil, false
+	}
+}
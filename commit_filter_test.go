@@ -143,3 +143,21 @@ func TestCommitFilter(t *testing.T) {
 		}, false)),
 	)
 }
+
+func TestCommitFilterMultiValued(t *testing.T) {
+	assert := assert.New(t)
+
+	fixtures := []*Commit{
+		{Subject: "1", JiraIssue: &JiraIssue{Fields: map[string]interface{}{"FixVersions": []string{"1.1.0", "1.2.0"}}}},
+		{Subject: "2", JiraIssue: &JiraIssue{Fields: map[string]interface{}{"FixVersions": []string{"1.3.0"}}}},
+		{Subject: "3", JiraIssue: &JiraIssue{Fields: map[string]interface{}{}}},
+		{Subject: "4"},
+	}
+
+	res := commitFilter(fixtures, map[string][]string{
+		"JiraIssue.Fields.FixVersions": {"1.2.0"},
+	}, false)
+
+	assert.Len(res, 1)
+	assert.Equal("1", res[0].Subject)
+}
@@ -24,8 +24,8 @@ var (
 
 	// formats
 	hashFormat      = hashField + ":%H\t%h"
-	authorFormat    = authorField + ":%an\t%ae\t%at"
-	committerFormat = committerField + ":%cn\t%ce\t%ct"
+	authorFormat    = authorField + ":%aN\t%aE\t%at"
+	committerFormat = committerField + ":%cN\t%cE\t%ct"
 	subjectFormat   = subjectField + ":%s"
 	bodyFormat      = bodyField + ":%b"
 
@@ -51,42 +51,79 @@ type commitParser struct {
 	logger                 *Logger
 	client                 gitcmd.Client
 	jiraClient             JiraClient
+	mailmap                *mailmap
 	config                 *Config
 	reHeader               *regexp.Regexp
 	reMerge                *regexp.Regexp
 	reRevert               *regexp.Regexp
 	reRef                  *regexp.Regexp
 	reIssue                *regexp.Regexp
+	reJiraRef              *regexp.Regexp
+	reKeyRef               *regexp.Regexp
 	reNotes                *regexp.Regexp
 	reMention              *regexp.Regexp
 	reSignOff              *regexp.Regexp
 	reCoAuthor             *regexp.Regexp
+	reJiraIssueKey         *regexp.Regexp
 	reJiraIssueDescription *regexp.Regexp
+	jiraIssues             map[string]*JiraIssue // issues fetched by key, `nil` if it failed
+	issues                 map[string]*Issue     // issues fetched from the IssueTracker, `nil` if it failed
+	issueTracker           IssueTracker
+	problems               []string // enrichment errors and unparseable headers, reported in strict mode
 }
 
 func newCommitParser(logger *Logger, client gitcmd.Client, jiraClient JiraClient, config *Config) *commitParser {
 	opts := config.Options
 
 	joinedRefActions := joinAndQuoteMeta(opts.RefActions, "|")
-	joinedIssuePrefix := joinAndQuoteMeta(opts.IssuePrefix, "|")
+	joinedIssuePrefix := joinAndQuoteMeta(append(append([]string{}, opts.IssuePrefix...), opts.PullRequestPrefix...), "|")
 	joinedNoteKeywords := joinAndQuoteMeta(opts.NoteKeywords, "|")
 
-	return &commitParser{
+	// any key would match `UTF-8` or `SHA-256`, so the scan and the refs are enabled by the project keys only
+	var reJiraIssueKey, reJiraRef *regexp.Regexp
+	if opts.JiraIssueKeyPattern != "" {
+		reJiraIssueKey = regexp.MustCompile("\\b((?:" + opts.JiraIssueKeyPattern + ")-\\d+)\\b")
+		reJiraRef = regexp.MustCompile("(?i:(" + joinedRefActions + "))\\s?((?:" + opts.JiraIssueKeyPattern + ")-\\d+)\\b")
+	}
+
+	// keys of the other trackers (e.g. Linear, YouTrack)
+	var reKeyRef *regexp.Regexp
+	if opts.IssueKeyPattern != "" {
+		reKeyRef = regexp.MustCompile("(?i:(" + joinedRefActions + "))\\s?((?:" + opts.IssueKeyPattern + ")-\\d+)\\b")
+	}
+
+	p := &commitParser{
 		logger:                 logger,
 		client:                 client,
 		jiraClient:             jiraClient,
+		mailmap:                newMailmap(client),
 		config:                 config,
 		reHeader:               regexp.MustCompile(opts.HeaderPattern),
 		reMerge:                regexp.MustCompile(opts.MergePattern),
 		reRevert:               regexp.MustCompile(opts.RevertPattern),
-		reRef:                  regexp.MustCompile("(?i)(" + joinedRefActions + ")\\s?([\\w/\\.\\-]+)?(?:" + joinedIssuePrefix + ")(\\d+)"),
-		reIssue:                regexp.MustCompile("(?:" + joinedIssuePrefix + ")(\\d+)"),
+		reRef:                  regexp.MustCompile("(?i)(" + joinedRefActions + ")\\s?([\\w/\\.\\-]+)?(" + joinedIssuePrefix + ")(\\d+)"),
+		reIssue:                regexp.MustCompile("(" + joinedIssuePrefix + ")(\\d+)"),
+		reJiraRef:              reJiraRef,
+		reKeyRef:               reKeyRef,
 		reNotes:                regexp.MustCompile("^(?i)\\s*(" + joinedNoteKeywords + ")[:\\s]+(.*)"),
 		reMention:              regexp.MustCompile(`@([\w-]+)`),
 		reSignOff:              regexp.MustCompile(`Signed-off-by:\s+([\p{L}\s\-\[\]]+)\s+<([\w+\-\[\].@]+)>`),
 		reCoAuthor:             regexp.MustCompile(`Co-authored-by:\s+([\p{L}\s\-\[\]]+)\s+<([\w+\-\[\].@]+)>`),
+		reJiraIssueKey:         reJiraIssueKey,
 		reJiraIssueDescription: regexp.MustCompile(opts.JiraIssueDescriptionPattern),
+		jiraIssues:             map[string]*JiraIssue{},
+		issues:                 map[string]*Issue{},
+		issueTracker:           opts.IssueTracker,
 	}
+
+	// a Jira tracker without a client shares the issues fetched for `JiraIssues`
+	if t, ok := opts.IssueTracker.(*jiraIssueTracker); ok && t.client == nil && jiraClient != nil {
+		bound := *t
+		bound.getJiraIssue = p.getJiraIssue
+		p.issueTracker = &bound
+	}
+
+	return p
 }
 
 func (p *commitParser) Parse(rev string) ([]*Commit, error) {
@@ -109,25 +146,115 @@ func (p *commitParser) Parse(rev string) ([]*Commit, error) {
 		return nil, err
 	}
 
-	processor := p.config.Options.Processor
+	processors := p.config.Options.processors()
 	lines := strings.Split(out, separator)
 	lines = lines[1:]
 	commits := make([]*Commit, len(lines))
 
 	for i, line := range lines {
-		commit := p.parseCommit(line)
+		commits[i] = p.parseCommit(line)
+	}
 
-		if processor != nil {
-			commit = processor.ProcessCommit(commit)
-			if commit == nil {
-				continue
+	p.normalizeContacts(commits)
+	p.processIssues(commits)
+
+	for i, commit := range commits {
+		commits[i] = p.processCommit(processors, commit)
+	}
+
+	return commits, nil
+}
+
+// normalizeContacts applies `.mailmap` to the contacts of trailers (authors and committers are already by git-log)
+// and assigns the usernames of `Aliases`
+func (p *commitParser) normalizeContacts(commits []*Commit) {
+	aliases := p.config.Options.Aliases
+	contacts := []Contact{}
+
+	for _, commit := range commits {
+		contacts = append(contacts, commit.CoAuthors...)
+		contacts = append(contacts, commit.Signers...)
+	}
+
+	if len(contacts) > 0 {
+		p.mailmap.Resolve(contacts)
+	}
+
+	for _, commit := range commits {
+		if commit.Author != nil {
+			commit.Author.Username = lookupAlias(aliases, commit.Author.Email)
+		}
+		commit.CoAuthors = p.resolveContacts(commit.CoAuthors)
+		commit.Signers = p.resolveContacts(commit.Signers)
+	}
+}
+
+func (p *commitParser) resolveContacts(contacts []Contact) []Contact {
+	if len(contacts) == 0 {
+		return contacts
+	}
+
+	resolved := p.mailmap.Resolve(contacts)
+	for i := range resolved {
+		resolved[i].Username = lookupAlias(p.config.Options.Aliases, resolved[i].Email)
+	}
+
+	return resolved
+}
+
+// processIssues assigns the issues of refs from the IssueTracker, each issue is fetched once
+func (p *commitParser) processIssues(commits []*Commit) {
+	tracker := p.issueTracker
+	if tracker == nil {
+		return
+	}
+
+	for _, commit := range commits {
+		for _, ref := range commit.Refs {
+			key := ref.Kind + "\x00" + ref.Source + "\x00" + ref.Ref
+			issue, ok := p.issues[key]
+			if !ok {
+				var err error
+				if issue, err = tracker.GetIssue(ref); err != nil {
+					p.reportError(fmt.Sprintf("Failed to get issue %s%s: %s", ref.Source, ref.Ref, err))
+				}
+				p.issues[key] = issue
 			}
+			ref.Issue = issue
 		}
+	}
+}
 
-		commits[i] = commit
+// reset forgets the problems of a previous run and the failed issues, so that they are fetched and reported again
+func (p *commitParser) reset() {
+	p.problems = nil
+	for key, issue := range p.jiraIssues {
+		if issue == nil {
+			delete(p.jiraIssues, key)
+		}
+	}
+	for key, issue := range p.issues {
+		if issue == nil {
+			delete(p.issues, key)
+		}
 	}
+}
 
-	return commits, nil
+// reportError logs an enrichment error and keeps it for the strict mode
+func (p *commitParser) reportError(msg string) {
+	p.logger.Error(msg + "\n")
+	p.problems = append(p.problems, msg)
+}
+
+// processCommit applies the processors in order, a processor returning nil drops the commit
+func (*commitParser) processCommit(processors []Processor, commit *Commit) *Commit {
+	for _, processor := range processors {
+		commit = processor.ProcessCommit(commit)
+		if commit == nil {
+			return nil
+		}
+	}
+	return commit
 }
 
 func (p *commitParser) parseCommit(input string) *Commit {
@@ -156,6 +283,26 @@ func (p *commitParser) parseCommit(input string) *Commit {
 	commit.Refs = p.uniqRefs(commit.Refs)
 	commit.Mentions = p.uniqMentions(commit.Mentions)
 
+	p.processJiraIssues(commit)
+
+	return commit
+}
+
+// parseMessage parses a commit message without a commit (e.g. in a `commit-msg` hook)
+func (p *commitParser) parseMessage(message string) *Commit {
+	commit := &Commit{}
+	lines := strings.SplitN(convNewline(message, "\n"), "\n", 2)
+
+	p.processHeader(commit, strings.TrimSpace(lines[0]))
+	if len(lines) > 1 {
+		p.processBody(commit, strings.TrimSpace(lines[1]))
+	} else {
+		p.processBody(commit, "")
+	}
+
+	commit.Refs = p.uniqRefs(commit.Refs)
+	commit.Mentions = p.uniqMentions(commit.Mentions)
+
 	return commit
 }
 
@@ -205,6 +352,7 @@ func (p *commitParser) processHeader(commit *Commit, input string) {
 	if len(res) > 0 {
 		assignDynamicValues(commit, opts.HeaderPatternMaps, res[0][1:])
 	}
+	parsed := len(res) > 0
 
 	// Merge
 	res = p.reMerge.FindAllStringSubmatch(input, -1)
@@ -226,12 +374,28 @@ func (p *commitParser) processHeader(commit *Commit, input string) {
 	commit.Refs = p.parseRefs(input)
 	commit.Mentions = p.parseMentions(input)
 
-	// Jira
-	if commit.JiraIssueID != "" {
-		p.processJiraIssue(commit, commit.JiraIssueID)
+	if !parsed {
+		p.reportUnparsedHeader(commit)
 	}
 }
 
+// reportUnparsedHeader keeps a header matching none of the patterns for the strict mode
+func (p *commitParser) reportUnparsedHeader(commit *Commit) {
+	opts := p.config.Options
+
+	// an empty pattern matches any header
+	if (commit.Merge != nil && opts.MergePattern != "") || (commit.Revert != nil && opts.RevertPattern != "") {
+		return
+	}
+
+	hash := ""
+	if commit.Hash != nil {
+		hash = commit.Hash.Short
+	}
+
+	p.problems = append(p.problems, fmt.Sprintf("%s: header \"%s\" does not match the header pattern", hash, commit.Header))
+}
+
 func (p *commitParser) extractLineMetadata(commit *Commit, line string) bool {
 	meta := false
 
@@ -330,16 +494,18 @@ func (p *commitParser) parseRefs(input string) []*Ref {
 		refs = append(refs, &Ref{
 			Action: r[1],
 			Source: r[2],
-			Ref:    r[3],
+			Ref:    r[4],
+			Kind:   p.refKind(r[3]),
 		})
 	}
 
 	// issues
 	res = p.reIssue.FindAllStringSubmatch(input, -1)
 	for _, r := range res {
+		kind := p.refKind(r[1])
 		duplicate := false
 		for _, ref := range refs {
-			if ref.Ref == r[1] {
+			if ref.Ref == r[2] && ref.Kind == kind {
 				duplicate = true
 			}
 		}
@@ -347,14 +513,62 @@ func (p *commitParser) parseRefs(input string) []*Ref {
 			refs = append(refs, &Ref{
 				Action: "",
 				Source: "",
-				Ref:    r[1],
+				Ref:    r[2],
+				Kind:   kind,
 			})
 		}
 	}
 
+	return p.parseExternalRefs(input, refs)
+}
+
+// parseExternalRefs appends the refs of the issue keys (e.g. `Closes PROJ-123`) to refs
+func (p *commitParser) parseExternalRefs(input string, refs []*Ref) []*Ref {
+	// issues of Jira
+	if opts := p.config.Options; p.reJiraRef != nil && (opts.JiraURL != "" || opts.JiraExport != "") {
+		for _, r := range p.reJiraRef.FindAllStringSubmatch(input, -1) {
+			ref := &Ref{
+				Action: r[1],
+				Ref:    r[2],
+				Kind:   RefKindExternal,
+			}
+			if opts.JiraURL != "" {
+				ref.URL = strings.TrimRight(opts.JiraURL, "/") + "/browse/" + r[2]
+			}
+			refs = append(refs, ref)
+		}
+	}
+
+	// issues of the other trackers (e.g. Linear), linked by the URL of `Ref.Issue`
+	if p.reKeyRef != nil && p.issueTracker != nil {
+		for _, r := range p.reKeyRef.FindAllStringSubmatch(input, -1) {
+			if !hasExternalRef(refs, r[2]) {
+				refs = append(refs, &Ref{Action: r[1], Ref: r[2], Kind: RefKindExternal})
+			}
+		}
+	}
+
 	return refs
 }
 
+func hasExternalRef(refs []*Ref, key string) bool {
+	for _, ref := range refs {
+		if ref.Kind == RefKindExternal && ref.Ref == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *commitParser) refKind(prefix string) string {
+	for _, s := range p.config.Options.PullRequestPrefix {
+		if strings.EqualFold(s, prefix) {
+			return RefKindPullRequest
+		}
+	}
+	return RefKindIssue
+}
+
 func (p *commitParser) parseSigners(input string) []Contact {
 	res := p.reSignOff.FindAllStringSubmatch(input, -1)
 	contacts := make([]Contact, len(res))
@@ -426,26 +640,123 @@ func (p *commitParser) uniqMentions(mentions []string) []string {
 	return arr
 }
 
-func (p *commitParser) processJiraIssue(commit *Commit, issueID string) {
-	issue, err := p.jiraClient.GetJiraIssue(commit.JiraIssueID)
-	if err != nil {
-		p.logger.Error(fmt.Sprintf("Failed to parse Jira story %s: %s\n", issueID, err))
+// processJiraIssues assigns the issue of `JiraIssueID` and, when Jira or an export of it is configured
+// and `JiraIssueKeyPattern` is set, the issues of all the keys of those projects found in the header and body
+func (p *commitParser) processJiraIssues(commit *Commit) {
+	if p.jiraClient == nil {
 		return
 	}
-	commit.Type = p.config.Options.JiraTypeMaps[issue.Fields.Type.Name]
-	commit.JiraIssue = &JiraIssue{
+
+	keys := []string{}
+	if commit.JiraIssueID != "" {
+		keys = append(keys, commit.JiraIssueID)
+	}
+	if opts := p.config.Options; p.reJiraIssueKey != nil && (opts.JiraURL != "" || opts.JiraExport != "") {
+		for _, r := range p.reJiraIssueKey.FindAllStringSubmatch(commit.Header+"\n"+commit.Body, -1) {
+			keys = append(keys, r[1])
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		if issue := p.getJiraIssue(key); issue != nil {
+			commit.JiraIssues = append(commit.JiraIssues, issue)
+		}
+	}
+
+	if commit.JiraIssueID != "" {
+		if issue := p.getJiraIssue(commit.JiraIssueID); issue != nil {
+			p.assignJiraType(commit, issue)
+			commit.JiraIssue = issue
+		}
+	}
+}
+
+// assignJiraType maps the labels (`JiraLabelTypeMaps`) or else the type (`JiraTypeMaps`) of the issue to the type of the commit.
+// An unmapped issue keeps the type of the commit, `JiraTypeDefault` applies if there is none.
+func (p *commitParser) assignJiraType(commit *Commit, issue *JiraIssue) {
+	opts := p.config.Options
+
+	jiraType := ""
+	for _, label := range issue.Labels {
+		if t, ok := opts.JiraLabelTypeMaps[label]; ok {
+			jiraType = t
+			break
+		}
+	}
+	if jiraType == "" {
+		jiraType = opts.JiraTypeMaps[issue.Type]
+	}
+
+	if jiraType != "" && (commit.Type == "" || opts.JiraTypePrecedence != JiraTypePrecedenceCommit) {
+		commit.Type = jiraType
+	}
+
+	if commit.Type == "" {
+		commit.Type = opts.JiraTypeDefault
+	}
+}
+
+// getJiraIssue fetches an issue once, the result is shared by the commits
+func (p *commitParser) getJiraIssue(key string) *JiraIssue {
+	if issue, ok := p.jiraIssues[key]; ok {
+		return issue
+	}
+
+	issue, err := p.jiraClient.GetJiraIssue(key)
+	if err != nil {
+		p.reportError(fmt.Sprintf("Failed to parse Jira story %s: %s", key, err))
+		p.jiraIssues[key] = nil
+		return nil
+	}
+
+	jiraIssue := &JiraIssue{
+		Key:         key,
 		Type:        issue.Fields.Type.Name,
 		Summary:     issue.Fields.Summary,
 		Description: issue.Fields.Description,
 		Labels:      issue.Fields.Labels,
+		Status:      jiraStatus(issue.Fields),
+		Fields:      extractJiraFields(issue.Fields, p.config.Options.JiraFields),
+		FixVersions: newJiraVersions(issue.Fields.FixVersions),
 	}
 
 	if p.config.Options.JiraIssueDescriptionPattern != "" {
-		res := p.reJiraIssueDescription.FindStringSubmatch(commit.JiraIssue.Description)
+		res := p.reJiraIssueDescription.FindStringSubmatch(jiraIssue.Description)
 		if len(res) > 1 {
-			commit.JiraIssue.Description = res[1]
+			jiraIssue.Description = res[1]
+		}
+	}
+
+	p.jiraIssues[key] = jiraIssue
+
+	return jiraIssue
+}
+
+// uniqJiraIssues returns the Jira issues of commits in order of appearance, without duplicates
+func uniqJiraIssues(commits []*Commit) []*JiraIssue {
+	issues := []*JiraIssue{}
+	seen := map[string]bool{}
+
+	for _, commit := range commits {
+		if commit == nil {
+			continue
+		}
+		for _, issue := range commit.JiraIssues {
+			if seen[issue.Key] {
+				continue
+			}
+			seen[issue.Key] = true
+			issues = append(issues, issue)
 		}
 	}
+
+	return issues
 }
 
 var (
@@ -455,15 +766,30 @@ var (
 		"    ",
 		"\t",
 	}
+
+	// only the explicit fences, indentation is ambiguous with nested lists
+	codeFenceTypes = []string{
+		"```",
+		"~~~",
+	}
 )
 
 type mdFenceDetector struct {
 	fence int
+	types []string
 }
 
 func newMdFenceDetector() *mdFenceDetector {
 	return &mdFenceDetector{
 		fence: -1,
+		types: fenceTypes,
+	}
+}
+
+func newMdCodeFenceDetector() *mdFenceDetector {
+	return &mdFenceDetector{
+		fence: -1,
+		types: codeFenceTypes,
 	}
 }
 
@@ -472,7 +798,7 @@ func (d *mdFenceDetector) InCodeblock() bool {
 }
 
 func (d *mdFenceDetector) Update(input string) {
-	for i, s := range fenceTypes {
+	for i, s := range d.types {
 		if d.fence < 0 {
 			if strings.Index(input, s) == 0 {
 				d.fence = i
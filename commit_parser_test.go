@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -100,6 +101,7 @@ func TestCommitParserParse(t *testing.T) {
 					Action: "",
 					Ref:    "123",
 					Source: "",
+					Kind:   RefKindIssue,
 				},
 			},
 			Notes:       []*Note{},
@@ -136,16 +138,19 @@ func TestCommitParserParse(t *testing.T) {
 					Action: "",
 					Ref:    "3",
 					Source: "",
+					Kind:   RefKindIssue,
 				},
 				{
 					Action: "Fixes",
 					Ref:    "3",
 					Source: "",
+					Kind:   RefKindIssue,
 				},
 				{
 					Action: "Closes",
 					Ref:    "1",
 					Source: "",
+					Kind:   RefKindIssue,
 				},
 			},
 			Notes: []*Note{
@@ -225,11 +230,13 @@ BREAKING CHANGE: This is breaking point message.`,
 					Action: "Fixes",
 					Ref:    "123",
 					Source: "",
+					Kind:   RefKindIssue,
 				},
 				{
 					Action: "Closes",
 					Ref:    "456",
 					Source: "username/repository",
+					Kind:   RefKindIssue,
 				},
 			},
 			Notes: []*Note{
@@ -412,5 +419,266 @@ func TestCommitParserParseWithJira(t *testing.T) {
 	assert.Equal(commit.JiraIssue.Summary, "summary of JIRA-1111")
 	assert.Equal(commit.JiraIssue.Description, "description of JIRA-1111")
 	assert.Equal(commit.JiraIssue.Labels, []string{"GA"})
+	assert.Equal(commit.JiraIssues, []*JiraIssue{commit.JiraIssue})
 	assert.Equal(commit.Type, "feat")
 }
+
+type funcProcessor struct {
+	process func(*Commit) *Commit
+}
+
+func (*funcProcessor) Bootstrap(*Config) {}
+
+func (p *funcProcessor) ProcessCommit(commit *Commit) *Commit {
+	return p.process(commit)
+}
+
+func TestCommitParserParseWithProcessors(t *testing.T) {
+	assert := assert.New(t)
+
+	mock := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			bytes, _ := os.ReadFile(filepath.Join("testdata", "gitlog.txt"))
+			return string(bytes), nil
+		},
+	}
+
+	calls := []string{}
+
+	parser := newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true),
+		mock, nil, &Config{
+			Options: &Options{
+				Processor: &funcProcessor{func(commit *Commit) *Commit {
+					calls = append(calls, "deprecated")
+					return commit
+				}},
+				Processors: []Processor{
+					&funcProcessor{func(commit *Commit) *Commit {
+						calls = append(calls, "redact")
+						commit.Subject = strings.ReplaceAll(commit.Subject, "#123", "#***")
+						return commit
+					}},
+					&funcProcessor{func(commit *Commit) *Commit {
+						calls = append(calls, "drop")
+						if commit.Merge != nil {
+							return nil
+						}
+						return commit
+					}},
+				},
+				HeaderPattern: "^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$",
+				HeaderPatternMaps: []string{
+					"Type",
+					"Scope",
+					"Subject",
+				},
+				MergePattern: "^Merge pull request #(\\d+) from (.*)$",
+				MergePatternMaps: []string{
+					"Ref",
+					"Source",
+				},
+			},
+		})
+
+	commits, err := parser.Parse("HEAD")
+	assert.Nil(err)
+	assert.Equal([]string{"deprecated", "redact", "drop"}, calls[:3])
+	assert.Equal("Add new feature #***", commits[0].Subject)
+	assert.Nil(commits[1])
+}
+
+func TestCommitParserParseRefKinds(t *testing.T) {
+	assert := assert.New(t)
+
+	parser := newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true),
+		nil, nil, &Config{
+			Options: &Options{
+				HeaderPattern:       "^(.*)$",
+				IssuePrefix:         []string{"#"},
+				PullRequestPrefix:   []string{"!"},
+				RefActions:          []string{"Closes", "Fixes"},
+				JiraURL:             "https://jira.example.com/",
+				JiraIssueKeyPattern: "PROJ",
+			},
+		})
+
+	assert.Equal([]*Ref{
+		{Action: "Closes", Ref: "12", Kind: RefKindIssue},
+		{Action: "Fixes", Ref: "34", Source: "group/project", Kind: RefKindPullRequest},
+		{Ref: "12", Kind: RefKindPullRequest},
+		{Action: "Closes", Ref: "PROJ-56", Kind: RefKindExternal, URL: "https://jira.example.com/browse/PROJ-56"},
+	}, parser.parseRefs("Closes #12, Fixes group/project!34 via !12, fixes UTF-8 decoding and Closes PROJ-56"))
+
+	// without the project keys, no word is taken for a Jira key
+	parser = newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true),
+		nil, nil, &Config{
+			Options: &Options{
+				HeaderPattern: "^(.*)$",
+				IssuePrefix:   []string{"#"},
+				RefActions:    []string{"Closes", "Fixes"},
+				JiraURL:       "https://jira.example.com/",
+			},
+		})
+
+	assert.Empty(parser.parseRefs("Fixes UTF-8 decoding and Closes PROJ-56"))
+}
+
+func TestCommitParserParseWithMailmap(t *testing.T) {
+	assert := assert.New(t)
+
+	mock := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			switch subcmd {
+			case "log":
+				return separator + strings.Join([]string{
+					"HASH:65cf1add9735dcc4810dda3312b0792236c97c4e\t65cf1add",
+					"AUTHOR:Alice\talice@example.com\t1514808000",
+					"COMMITTER:Alice\talice@example.com\t1514808000",
+					"SUBJECT:feat: Add feature",
+					"BODY:Co-authored-by: bob <bob@old.example.com>\nSigned-off-by: Carol <carol@example.com>",
+				}, delimiter), nil
+			case "check-mailmap":
+				lines := make([]string, len(args))
+				for i, arg := range args {
+					lines[i] = strings.Replace(arg, "bob <bob@old.example.com>", "Bob <bob@example.com>", 1)
+				}
+				return strings.Join(lines, "\n") + "\n", nil
+			}
+			return "", errors.New("")
+		},
+	}
+
+	parser := newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true),
+		mock, nil, &Config{
+			Options: &Options{
+				HeaderPattern:     "^(.*)$",
+				HeaderPatternMaps: []string{"Subject"},
+				Aliases: map[string]string{
+					"alice@example.com": "alice",
+					"BOB@example.com":   "bob",
+				},
+			},
+		})
+
+	commits, err := parser.Parse("HEAD")
+	assert.Nil(err)
+	assert.Equal("alice", commits[0].Author.Username)
+	assert.Equal([]Contact{{Name: "Bob", Email: "bob@example.com", Username: "bob"}}, commits[0].CoAuthors)
+	assert.Equal([]Contact{{Name: "Carol", Email: "carol@example.com"}}, commits[0].Signers)
+}
+
+type countingJiraClient struct {
+	mockJiraClient
+	calls map[string]int
+}
+
+func (jira *countingJiraClient) GetJiraIssue(id string) (*agjira.Issue, error) {
+	jira.calls[id]++
+	return jira.mockJiraClient.GetJiraIssue(id)
+}
+
+func TestCommitParserParseWithJiraKeys(t *testing.T) {
+	assert := assert.New(t)
+
+	mock := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			if subcmd != "log" {
+				return "", errors.New("")
+			}
+
+			bytes, _ := os.ReadFile(filepath.Join("testdata", "gitlog_jira_keys.txt"))
+
+			return string(bytes), nil
+		},
+	}
+
+	jira := &countingJiraClient{calls: map[string]int{}}
+	parser := newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true),
+		mock, jira, &Config{
+			Options: &Options{
+				HeaderPattern: "^(\\w*)\\:\\s(.*)$",
+				HeaderPatternMaps: []string{
+					"Type",
+					"Subject",
+				},
+				JiraURL:             "https://jira.example.com",
+				JiraIssueKeyPattern: "PROJ|OPS",
+			},
+		})
+
+	commits, err := parser.Parse("HEAD")
+	assert.Nil(err)
+
+	keys := func(issues []*JiraIssue) []string {
+		arr := []string{}
+		for _, issue := range issues {
+			arr = append(arr, issue.Key)
+		}
+		return arr
+	}
+
+	assert.Equal([]string{"PROJ-1", "PROJ-2", "OPS-3"}, keys(commits[0].JiraIssues))
+	assert.Equal("summary of OPS-3", commits[0].JiraIssues[2].Summary)
+	assert.Nil(commits[0].JiraIssue)
+	assert.Equal("feat", commits[0].Type)
+	assert.Equal([]string{"PROJ-2"}, keys(commits[1].JiraIssues))
+	assert.Same(commits[0].JiraIssues[1], commits[1].JiraIssues[0])
+	assert.Equal(map[string]int{"PROJ-1": 1, "PROJ-2": 1, "OPS-3": 1}, jira.calls)
+
+	assert.Equal([]string{"PROJ-1", "PROJ-2", "OPS-3"}, keys(uniqJiraIssues(commits)))
+
+	// without project keys, `UTF-8` and the like are not taken for issues
+	jira = &countingJiraClient{calls: map[string]int{}}
+	parser = newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true),
+		mock, jira, &Config{
+			Options: &Options{
+				HeaderPattern: "^(\\w*)\\:\\s(.*)$",
+				HeaderPatternMaps: []string{
+					"Type",
+					"Subject",
+				},
+				JiraURL: "https://jira.example.com",
+			},
+		})
+
+	commits, err = parser.Parse("HEAD")
+	assert.Nil(err)
+	assert.Empty(commits[0].JiraIssues)
+	assert.Empty(commits[1].JiraIssues)
+	assert.Empty(jira.calls)
+}
+
+func TestCommitParserAssignJiraType(t *testing.T) {
+	assert := assert.New(t)
+
+	table := []struct {
+		name       string
+		commitType string
+		issue      *JiraIssue
+		precedence string
+		expected   string
+	}{
+		{"mapped type", "", &JiraIssue{Type: "Story"}, "", "feat"},
+		{"mapped type replaces the commit type", "fix", &JiraIssue{Type: "Story"}, "", "feat"},
+		{"unmapped type keeps the commit type", "fix", &JiraIssue{Type: "Epic"}, "", "fix"},
+		{"unmapped type falls back to the default", "", &JiraIssue{Type: "Epic"}, "", "chore"},
+		{"label is preferred to the type", "", &JiraIssue{Type: "Story", Labels: []string{"GA", "security"}}, "", "security"},
+		{"commit type wins", "fix", &JiraIssue{Type: "Story"}, JiraTypePrecedenceCommit, "fix"},
+		{"commit type is filled in", "", &JiraIssue{Type: "Story"}, JiraTypePrecedenceCommit, "feat"},
+	}
+
+	for _, sa := range table {
+		parser := newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true), nil, nil, &Config{
+			Options: &Options{
+				JiraTypeMaps:       map[string]string{"Story": "feat"},
+				JiraLabelTypeMaps:  map[string]string{"security": "security"},
+				JiraTypePrecedence: sa.precedence,
+				JiraTypeDefault:    "chore",
+			},
+		})
+
+		commit := &Commit{Type: sa.commitType}
+		parser.assignJiraType(commit, sa.issue)
+		assert.Equal(sa.expected, commit.Type, sa.name)
+	}
+}
@@ -0,0 +1,145 @@
+package chglog
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+)
+
+// contributorReader collects the authors and co-authors of commits, deduplicated by email after applying `.mailmap`,
+// and detects those whose first commit in the history is one of them
+type contributorReader struct {
+	client  gitcmd.Client
+	mailmap *mailmap
+	aliases map[string]string
+	firsts  map[string]string // email -> hash of the first commit
+}
+
+func newContributorReader(client gitcmd.Client, aliases map[string]string) *contributorReader {
+	return &contributorReader{
+		client:  client,
+		mailmap: newMailmap(client),
+		aliases: aliases,
+	}
+}
+
+// Read returns the contributors of commits and the new ones among them
+func (r *contributorReader) Read(commits []*Commit) ([]*Contributor, []*Contributor, error) {
+	if r.firsts == nil {
+		if err := r.loadFirsts(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	contacts := []Contact{}
+	hashes := map[string]bool{}
+
+	for _, commit := range commits {
+		if commit == nil {
+			continue
+		}
+		if commit.Hash != nil {
+			hashes[commit.Hash.Long] = true
+		}
+		if commit.Author != nil {
+			contacts = append(contacts, Contact{Name: commit.Author.Name, Email: commit.Author.Email})
+		}
+		contacts = append(contacts, commit.CoAuthors...)
+	}
+
+	contributors := []*Contributor{}
+	newContributors := []*Contributor{}
+	seen := map[string]bool{}
+
+	for _, contact := range r.mailmap.Resolve(contacts) {
+		email := strings.ToLower(contact.Email)
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+
+		contributor := &Contributor{
+			Name:     contact.Name,
+			Email:    contact.Email,
+			Username: lookupAlias(r.aliases, contact.Email),
+		}
+		contributors = append(contributors, contributor)
+
+		if hashes[r.firsts[email]] {
+			newContributors = append(newContributors, contributor)
+		}
+	}
+
+	sortContributors(contributors)
+	sortContributors(newContributors)
+
+	return contributors, newContributors, nil
+}
+
+// loadFirsts reads the first commit of each contributor in the history
+func (r *contributorReader) loadFirsts() error {
+	out, err := r.client.Exec(
+		"log",
+		"HEAD",
+		"--no-decorate",
+		"--format="+separator+strings.Join([]string{
+			"%H",
+			"%aN",
+			"%aE",
+			"%(trailers:key=Co-authored-by,valueonly)",
+		}, delimiter),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to get git-log: %w", err)
+	}
+
+	type entry struct {
+		hash     string
+		contacts []Contact
+	}
+
+	entries := []entry{}
+	contacts := []Contact{}
+
+	for _, record := range strings.Split(out, separator) {
+		tokens := strings.Split(record, delimiter)
+		if len(tokens) != 4 {
+			continue
+		}
+
+		e := entry{
+			hash:     tokens[0],
+			contacts: []Contact{{Name: tokens[1], Email: tokens[2]}},
+		}
+
+		for _, line := range strings.Split(tokens[3], "\n") {
+			if contact := r.mailmap.parse(line); contact.Email != "" {
+				e.contacts = append(e.contacts, contact)
+			}
+		}
+
+		entries = append(entries, e)
+		contacts = append(contacts, e.contacts...)
+	}
+
+	// at once, the loop below hits the cache
+	r.mailmap.Resolve(contacts)
+
+	// git-log lists the newest commit first, the oldest one remains
+	r.firsts = map[string]string{}
+	for _, e := range entries {
+		for _, c := range r.mailmap.Resolve(e.contacts) {
+			r.firsts[strings.ToLower(c.Email)] = e.hash
+		}
+	}
+
+	return nil
+}
+
+func sortContributors(contributors []*Contributor) {
+	sort.SliceStable(contributors, func(i, j int) bool {
+		return strings.ToLower(contributors[i].Name) < strings.ToLower(contributors[j].Name)
+	})
+}
@@ -0,0 +1,79 @@
+package chglog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+)
+
+func TestContributorReader(t *testing.T) {
+	assert := assert.New(t)
+	testName := "contributor_reader"
+
+	setup(testName, func(commit commitFunc, tag tagFunc, git gitcmd.Client) {
+		author := func(date, name, subject, body string) {
+			_, _ = git.Exec("config", "user.name", name)
+			_, _ = git.Exec("config", "user.email", name+"@example.com")
+			commit(date, subject, body)
+		}
+
+		_ = os.WriteFile(".mailmap", []byte("Alice <alice@example.com>\nAlice <alice@example.com> <alice@old.example.com>\n"), 0600)
+
+		author("2018-01-01 00:00:00", "alice", "feat: Initial commit", "")
+		author("2018-01-02 00:00:00", "bob", "fix: Fix bug", "Co-authored-by: carol <carol@example.com>")
+		tag("1.0.0")
+
+		_, _ = git.Exec("config", "user.name", "alice")
+		_, _ = git.Exec("config", "user.email", "alice@old.example.com")
+		commit("2018-02-01 00:00:00", "feat: Add feature", "")
+		author("2018-02-02 00:00:00", "dave", "docs: Add docs", "Co-authored-by: Carol <carol@example.com>")
+		tag("1.1.0")
+	})
+
+	_ = os.Chdir(filepath.Join(cwd, testRepoRoot, testName))
+	defer func() {
+		_ = os.Chdir(cwd)
+	}()
+
+	client := gitcmd.New(nil)
+	parser := newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true), client, nil, &Config{
+		Options: &Options{
+			HeaderPattern:     "^(.*)$",
+			HeaderPatternMaps: []string{"Subject"},
+		},
+	})
+	reader := newContributorReader(client, map[string]string{
+		"Alice@example.com": "alice-gh",
+	})
+
+	// 1.1.0
+	commits, err := parser.Parse("1.0.0..1.1.0")
+	assert.Nil(err)
+
+	contributors, newContributors, err := reader.Read(commits)
+	assert.Nil(err)
+	assert.Equal([]*Contributor{
+		{Name: "Alice", Email: "alice@example.com", Username: "alice-gh"},
+		{Name: "Carol", Email: "carol@example.com"},
+		{Name: "dave", Email: "dave@example.com"},
+	}, contributors)
+	assert.Equal([]*Contributor{
+		{Name: "dave", Email: "dave@example.com"},
+	}, newContributors)
+
+	// 1.0.0
+	commits, err = parser.Parse("1.0.0")
+	assert.Nil(err)
+
+	contributors, newContributors, err = reader.Read(commits)
+	assert.Nil(err)
+	assert.Equal([]*Contributor{
+		{Name: "Alice", Email: "alice@example.com", Username: "alice-gh"},
+		{Name: "bob", Email: "bob@example.com"},
+		{Name: "carol", Email: "carol@example.com"},
+	}, contributors)
+	assert.Equal(contributors, newContributors)
+}
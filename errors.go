@@ -1,8 +1,23 @@
 package chglog
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
 
 var (
 	errNotFoundTag      = errors.New("could not find the tag")
+	errNotFoundTags     = errors.New("git-tag does not exist")
 	errFailedQueryParse = errors.New("failed to parse the query")
 )
+
+// StrictError is returned by `Generate` in strict mode instead of rendering, it lists every problem found:
+// enrichment errors (e.g. Jira), headers matching no pattern and tags of the query that do not exist
+type StrictError struct {
+	Problems []string
+}
+
+func (e *StrictError) Error() string {
+	return fmt.Sprintf("%d problem(s) found in strict mode:\n- %s", len(e.Problems), strings.Join(e.Problems, "\n- "))
+}
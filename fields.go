@@ -10,15 +10,17 @@ type Hash struct {
 
 // Contact of co-authors and signers
 type Contact struct {
-	Name  string
-	Email string
+	Name     string
+	Email    string
+	Username string // Username on the forge from the `Aliases` option (e.g. `tsuyoshiwada`)
 }
 
 // Author of commit
 type Author struct {
-	Name  string
-	Email string
-	Date  time.Time
+	Name     string
+	Email    string
+	Username string // Username on the forge from the `Aliases` option (e.g. `tsuyoshiwada`)
+	Date     time.Time
 }
 
 // Committer of commit
@@ -44,8 +46,18 @@ type Ref struct {
 	Action string // (e.g. `Closes`)
 	Ref    string // (e.g. `123`)
 	Source string // (e.g. `owner/repository`)
+	Kind   string // `RefKindIssue`, `RefKindPullRequest` or `RefKindExternal` (e.g. Jira)
+	URL    string // Link to the issue, set by processors (e.g. `https://github.com/owner/repository/issues/123`)
+	Issue  *Issue // Issue fetched from the `IssueTracker` option, `nil` if not available
 }
 
+// Kinds of Ref
+const (
+	RefKindIssue       = "issue"
+	RefKindPullRequest = "pull_request"
+	RefKindExternal    = "external"
+)
+
 // Note of commit
 type Note struct {
 	Title string // (e.g. `BREAKING CHANGE`)
@@ -60,10 +72,22 @@ type NoteGroup struct {
 
 // JiraIssue is information about a jira ticket (type, summary, description, and labels)
 type JiraIssue struct {
+	Key         string // (e.g. `RNWY-310`)
 	Type        string
 	Summary     string
 	Description string
 	Labels      []string
+	Status      string                 // (e.g. `In Progress`)
+	Fields      map[string]interface{} // Fields extracted by the `JiraFields` option, a `string` or a `[]string` (e.g. `Epic`, `FixVersions`)
+	FixVersions []*JiraVersion
+}
+
+// JiraVersion is a fix version of a Jira issue
+type JiraVersion struct {
+	Name        string
+	Description string
+	Released    bool
+	ReleaseDate time.Time // Zero if the release date is not set
 }
 
 // Commit data
@@ -75,15 +99,16 @@ type Commit struct {
 	Revert      *Revert // If it is not a revert commit, `nil` is assigned
 	Refs        []*Ref
 	Notes       []*Note
-	Mentions    []string   // Name of the user included in the commit header or body
-	CoAuthors   []Contact  // (e.g. `Co-authored-by: user <user@email>`)
-	Signers     []Contact  // (e.g. `Signed-off-by: user <user@email>`)
-	JiraIssue   *JiraIssue // If no issue id found in header, `nil` is assigned
-	Header      string     // (e.g. `feat(core)[RNWY-310]: Add new feature`)
-	Type        string     // (e.g. `feat`)
-	Scope       string     // (e.g. `core`)
-	Subject     string     // (e.g. `Add new feature`)
-	JiraIssueID string     // (e.g. `RNWY-310`)
+	Mentions    []string     // Name of the user included in the commit header or body
+	CoAuthors   []Contact    // (e.g. `Co-authored-by: user <user@email>`)
+	Signers     []Contact    // (e.g. `Signed-off-by: user <user@email>`)
+	JiraIssue   *JiraIssue   // If no issue id found in header, `nil` is assigned
+	JiraIssues  []*JiraIssue // Issues of the Jira keys found in the header and body, in order of appearance
+	Header      string       // (e.g. `feat(core)[RNWY-310]: Add new feature`)
+	Type        string       // (e.g. `feat`)
+	Scope       string       // (e.g. `core`)
+	Subject     string       // (e.g. `Add new feature`)
+	JiraIssueID string       // (e.g. `RNWY-310`)
 	Body        string
 	TrimmedBody string // Body without any Notes/Refs/Mentions/CoAuthors/Signers
 }
@@ -99,28 +124,50 @@ type CommitGroup struct {
 // If you give `Tag`, the reference hierarchy will be deepened.
 // This struct is used to minimize the hierarchy of references
 type RelateTag struct {
-	Name    string
-	Subject string
-	Date    time.Time
+	Name        string
+	DisplayName string
+	Version     string
+	Subject     string
+	Date        time.Time
 }
 
 // Tag is data of git-tag
 type Tag struct {
-	Name     string
-	Subject  string
-	Date     time.Time
-	Next     *RelateTag
-	Previous *RelateTag
+	Name        string // Raw tag name, used for git ranges (e.g. `release-2025.04.1-prod`)
+	DisplayName string // Conversion by `TagNamePattern` option, or the raw tag name
+	Version     string // Conversion by `TagNamePattern` option, or the raw tag name (e.g. `2025.04.1`)
+	Subject     string
+	Date        time.Time
+	Next        *RelateTag
+	Previous    *RelateTag
 }
 
 // Version is a tag-separeted datset to be included in CHANGELOG
 type Version struct {
-	Tag           *Tag
-	CommitGroups  []*CommitGroup
-	Commits       []*Commit
-	MergeCommits  []*Commit
-	RevertCommits []*Commit
-	NoteGroups    []*NoteGroup
+	Tag             *Tag
+	CommitGroups    []*CommitGroup
+	Commits         []*Commit
+	MergeCommits    []*Commit
+	RevertCommits   []*Commit
+	NoteGroups      []*NoteGroup
+	Contributors    []*Contributor // Authors and co-authors of the commits, sorted by name. Only if `Contributors` is enabled
+	NewContributors []*Contributor // Contributors whose first commit in the history is in this version. Only if `Contributors` is enabled
+	JiraIssues      []*JiraIssue   // Jira issues of the commits, deduplicated
+}
+
+// Contributor is an author or a co-author of commits, `.mailmap` is applied
+type Contributor struct {
+	Name     string
+	Email    string
+	Username string // Username on the forge from the `Aliases` option (e.g. `tsuyoshiwada`)
+}
+
+// VersionGroup is a collection of `Version` grouped by major (or major.minor) series according to the `VersionGroupBy` option
+type VersionGroup struct {
+	Title    string    // Series title (e.g. `2.x`, `2.1.x`)
+	Date     time.Time // Date of the latest tag in the group
+	Tags     []*Tag
+	Versions []*Version
 }
 
 // Unreleased is unreleased commit dataset
@@ -130,4 +177,5 @@ type Unreleased struct {
 	MergeCommits  []*Commit
 	RevertCommits []*Commit
 	NoteGroups    []*NoteGroup
+	JiraIssues    []*JiraIssue // Jira issues of the commits, deduplicated
 }
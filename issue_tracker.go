@@ -0,0 +1,427 @@
+package chglog
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// IssueTracker fetches the issues referred to by commits, independently of the tracker.
+// It returns `nil` without error for refs it does not handle (e.g. pull requests of a Jira tracker).
+type IssueTracker interface {
+	GetIssue(ref *Ref) (*Issue, error)
+}
+
+// Issue is an issue of a tracker assigned to `Ref.Issue`
+type Issue struct {
+	ID     string // (e.g. `123`, `PROJ-123`)
+	Title  string
+	Type   string // (e.g. `Bug`), empty if the tracker has no type
+	Labels []string
+	URL    string
+	State  string // State as reported by the tracker (e.g. `closed`, `Done`)
+}
+
+const defaultIssueTrackerTimeout = 30 * time.Second
+
+// trackerClient requests the JSON API of a tracker
+type trackerClient struct {
+	client  *http.Client
+	baseURL string
+	header  http.Header
+}
+
+func newTrackerClient(baseURL string, header http.Header) *trackerClient {
+	return &trackerClient{
+		client:  &http.Client{Timeout: defaultIssueTrackerTimeout},
+		baseURL: strings.TrimRight(baseURL, "/"),
+		header:  header,
+	}
+}
+
+func (c *trackerClient) get(path string, v interface{}) error {
+	return c.do(http.MethodGet, path, nil, v)
+}
+
+// post sends body as JSON, e.g. the query of a GraphQL API
+func (c *trackerClient) post(path string, body interface{}, v interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	return c.do(http.MethodPost, path, bytes.NewReader(data), v)
+}
+
+func (c *trackerClient) do(method, path string, body io.Reader, v interface{}) error {
+	req, err := http.NewRequest(method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+
+	for key, values := range c.header {
+		req.Header[key] = values
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request %s: %w", req.URL, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get %s (status %d)", req.URL, res.StatusCode)
+	}
+
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// NewGitHubIssueTracker returns an IssueTracker of GitHub Issues, pull requests are supported too.
+// apiURL is `https://api.github.com` or `https://<host>/api/v3` for GitHub Enterprise,
+// repository is `owner/repository` and token may be empty for public repositories.
+func NewGitHubIssueTracker(apiURL, repository, token string) IssueTracker {
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+
+	return &gitHubIssueTracker{
+		client:     newTrackerClient(apiURL, header),
+		repository: repository,
+	}
+}
+
+type gitHubIssueTracker struct {
+	client     *trackerClient
+	repository string
+}
+
+func (t *gitHubIssueTracker) GetIssue(ref *Ref) (*Issue, error) {
+	if ref.Kind != RefKindIssue && ref.Kind != RefKindPullRequest {
+		return nil, nil
+	}
+
+	var res struct {
+		Title   string `json:"title"`
+		State   string `json:"state"`
+		HTMLURL string `json:"html_url"`
+		Labels  []struct {
+			Name string `json:"name"`
+		} `json:"labels"`
+		Type *struct {
+			Name string `json:"name"`
+		} `json:"type"`
+	}
+
+	err := t.client.get(fmt.Sprintf("/repos/%s/issues/%s", trackerRepository(ref, t.repository), ref.Ref), &res)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := &Issue{
+		ID:     ref.Ref,
+		Title:  res.Title,
+		Labels: []string{},
+		URL:    res.HTMLURL,
+		State:  res.State,
+	}
+	if res.Type != nil {
+		issue.Type = res.Type.Name
+	}
+	for _, label := range res.Labels {
+		issue.Labels = append(issue.Labels, label.Name)
+	}
+
+	return issue, nil
+}
+
+// NewGitLabIssueTracker returns an IssueTracker of GitLab Issues, merge requests are supported too.
+// apiURL is `https://gitlab.com/api/v4` or the one of a self-hosted instance,
+// project is the path of the project (e.g. `group/project`) and token may be empty for public projects.
+func NewGitLabIssueTracker(apiURL, project, token string) IssueTracker {
+	header := http.Header{}
+	if token != "" {
+		header.Set("PRIVATE-TOKEN", token)
+	}
+
+	return &gitLabIssueTracker{
+		client:  newTrackerClient(apiURL, header),
+		project: project,
+	}
+}
+
+type gitLabIssueTracker struct {
+	client  *trackerClient
+	project string
+}
+
+func (t *gitLabIssueTracker) GetIssue(ref *Ref) (*Issue, error) {
+	resource := "issues"
+	switch ref.Kind {
+	case RefKindIssue:
+	case RefKindPullRequest:
+		resource = "merge_requests"
+	default:
+		return nil, nil
+	}
+
+	var res struct {
+		Title     string   `json:"title"`
+		State     string   `json:"state"`
+		WebURL    string   `json:"web_url"`
+		Labels    []string `json:"labels"`
+		IssueType string   `json:"issue_type"`
+	}
+
+	project := url.PathEscape(trackerRepository(ref, t.project))
+	err := t.client.get(fmt.Sprintf("/projects/%s/%s/%s", project, resource, ref.Ref), &res)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := &Issue{
+		ID:     ref.Ref,
+		Title:  res.Title,
+		Type:   res.IssueType,
+		Labels: res.Labels,
+		URL:    res.WebURL,
+		State:  res.State,
+	}
+	if issue.Labels == nil {
+		issue.Labels = []string{}
+	}
+
+	return issue, nil
+}
+
+// trackerRepository returns the repository of a ref, refs to other repositories (e.g. `owner/repo#1`) are supported
+func trackerRepository(ref *Ref, repository string) string {
+	if strings.Contains(ref.Source, "/") {
+		return ref.Source
+	}
+	return repository
+}
+
+// NewLinearIssueTracker returns an IssueTracker of Linear for the issue keys (`RefKindExternal`, e.g. `ENG-123`).
+// apiURL is `https://api.linear.app` and token is a personal API key.
+func NewLinearIssueTracker(apiURL, token string) IssueTracker {
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", token)
+	}
+
+	return &linearIssueTracker{
+		client: newTrackerClient(apiURL, header),
+	}
+}
+
+type linearIssueTracker struct {
+	client *trackerClient
+}
+
+const linearIssueQuery = `query($id: String!) {
+  issue(id: $id) { title url state { name } labels { nodes { name } } }
+}`
+
+func (t *linearIssueTracker) GetIssue(ref *Ref) (*Issue, error) {
+	if ref.Kind != RefKindExternal {
+		return nil, nil
+	}
+
+	var res struct {
+		Data struct {
+			Issue *struct {
+				Title string `json:"title"`
+				URL   string `json:"url"`
+				State struct {
+					Name string `json:"name"`
+				} `json:"state"`
+				Labels struct {
+					Nodes []struct {
+						Name string `json:"name"`
+					} `json:"nodes"`
+				} `json:"labels"`
+			} `json:"issue"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	err := t.client.post("/graphql", map[string]interface{}{
+		"query":     linearIssueQuery,
+		"variables": map[string]string{"id": ref.Ref},
+	}, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Errors) > 0 {
+		return nil, errors.New(res.Errors[0].Message)
+	}
+	if res.Data.Issue == nil {
+		return nil, fmt.Errorf("issue %s was not found", ref.Ref)
+	}
+
+	issue := &Issue{
+		ID:     ref.Ref,
+		Title:  res.Data.Issue.Title,
+		Labels: []string{},
+		URL:    res.Data.Issue.URL,
+		State:  res.Data.Issue.State.Name,
+	}
+	for _, label := range res.Data.Issue.Labels.Nodes {
+		issue.Labels = append(issue.Labels, label.Name)
+	}
+
+	return issue, nil
+}
+
+// NewYouTrackIssueTracker returns an IssueTracker of YouTrack for the issue keys (`RefKindExternal`, e.g. `PROJ-123`).
+// baseURL is the one of the instance (e.g. `https://example.youtrack.cloud`), token is a permanent token.
+// `Type` and `State` are the values of the custom fields of those names, tags are the labels.
+func NewYouTrackIssueTracker(baseURL, token string) IssueTracker {
+	header := http.Header{}
+	if token != "" {
+		header.Set("Authorization", "Bearer "+token)
+	}
+
+	return &youTrackIssueTracker{
+		client: newTrackerClient(baseURL, header),
+	}
+}
+
+type youTrackIssueTracker struct {
+	client *trackerClient
+}
+
+func (t *youTrackIssueTracker) GetIssue(ref *Ref) (*Issue, error) {
+	if ref.Kind != RefKindExternal {
+		return nil, nil
+	}
+
+	var res struct {
+		Summary string `json:"summary"`
+		Tags    []struct {
+			Name string `json:"name"`
+		} `json:"tags"`
+		CustomFields []struct {
+			Name  string          `json:"name"`
+			Value json.RawMessage `json:"value"`
+		} `json:"customFields"`
+	}
+
+	path := fmt.Sprintf("/api/issues/%s?fields=summary,tags(name),customFields(name,value(name))", url.PathEscape(ref.Ref))
+	if err := t.client.get(path, &res); err != nil {
+		return nil, err
+	}
+
+	issue := &Issue{
+		ID:     ref.Ref,
+		Title:  res.Summary,
+		Labels: []string{},
+		URL:    t.client.baseURL + "/issue/" + ref.Ref,
+	}
+	for _, tag := range res.Tags {
+		issue.Labels = append(issue.Labels, tag.Name)
+	}
+	for _, field := range res.CustomFields {
+		// single value fields only, e.g. `{"name": "Bug"}`
+		var value struct {
+			Name string `json:"name"`
+		}
+		if json.Unmarshal(field.Value, &value) != nil {
+			continue
+		}
+		switch field.Name {
+		case "Type":
+			issue.Type = value.Name
+		case "State":
+			issue.State = value.Name
+		}
+	}
+
+	return issue, nil
+}
+
+// NewJiraIssueTracker returns an IssueTracker of the Jira keys (`RefKindExternal`) with a JiraClient,
+// jiraURL is used for the link of the issues. With a nil client, the issues are those the `Generator`
+// fetches for `JiraIssues`, so each key is requested (or read from `JiraExport`) once.
+func NewJiraIssueTracker(client JiraClient, jiraURL string) IssueTracker {
+	return &jiraIssueTracker{
+		client:  client,
+		jiraURL: strings.TrimRight(jiraURL, "/"),
+	}
+}
+
+type jiraIssueTracker struct {
+	client       JiraClient
+	jiraURL      string
+	getJiraIssue func(key string) *JiraIssue // bound by `commitParser` if client is nil, it reports the errors
+}
+
+func (t *jiraIssueTracker) GetIssue(ref *Ref) (*Issue, error) {
+	if ref.Kind != RefKindExternal {
+		return nil, nil
+	}
+
+	if t.client == nil {
+		return t.fromJiraIssue(ref), nil
+	}
+
+	res, err := t.client.GetJiraIssue(ref.Ref)
+	if err != nil {
+		return nil, err
+	}
+
+	issue := &Issue{
+		ID:     ref.Ref,
+		Labels: []string{},
+	}
+	if t.jiraURL != "" {
+		issue.URL = t.jiraURL + "/browse/" + ref.Ref
+	}
+	if fields := res.Fields; fields != nil {
+		issue.Title = fields.Summary
+		issue.Type = fields.Type.Name
+		issue.Labels = append(issue.Labels, fields.Labels...)
+		if fields.Status != nil {
+			issue.State = fields.Status.Name
+		}
+	}
+
+	return issue, nil
+}
+
+func (t *jiraIssueTracker) fromJiraIssue(ref *Ref) *Issue {
+	if t.getJiraIssue == nil {
+		return nil
+	}
+
+	res := t.getJiraIssue(ref.Ref)
+	if res == nil {
+		return nil
+	}
+
+	issue := &Issue{
+		ID:     ref.Ref,
+		Title:  res.Summary,
+		Type:   res.Type,
+		Labels: append([]string{}, res.Labels...),
+		State:  res.Status,
+	}
+	if t.jiraURL != "" {
+		issue.URL = t.jiraURL + "/browse/" + ref.Ref
+	}
+
+	return issue
+}
@@ -0,0 +1,313 @@
+package chglog
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitHubIssueTracker(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("Bearer secret", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/repos/owner/repo/issues/123":
+			_, _ = w.Write([]byte(`{"title":"Crash on start","state":"closed","html_url":"https://github.com/owner/repo/issues/123","labels":[{"name":"bug"}],"type":{"name":"Bug"}}`))
+		case "/repos/other/lib/issues/4":
+			_, _ = w.Write([]byte(`{"title":"Add option","state":"open","html_url":"https://github.com/other/lib/pull/4","labels":[]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	tracker := NewGitHubIssueTracker(server.URL, "owner/repo", "secret")
+
+	issue, err := tracker.GetIssue(&Ref{Ref: "123", Kind: RefKindIssue})
+	assert.Nil(err)
+	assert.Equal(&Issue{
+		ID:     "123",
+		Title:  "Crash on start",
+		Type:   "Bug",
+		Labels: []string{"bug"},
+		URL:    "https://github.com/owner/repo/issues/123",
+		State:  "closed",
+	}, issue)
+
+	issue, err = tracker.GetIssue(&Ref{Ref: "4", Source: "other/lib", Kind: RefKindPullRequest})
+	assert.Nil(err)
+	assert.Equal("Add option", issue.Title)
+
+	issue, err = tracker.GetIssue(&Ref{Ref: "PROJ-1", Kind: RefKindExternal})
+	assert.Nil(err)
+	assert.Nil(issue)
+
+	_, err = tracker.GetIssue(&Ref{Ref: "9", Kind: RefKindIssue})
+	assert.ErrorContains(err, "status 404")
+}
+
+func TestGitLabIssueTracker(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("secret", r.Header.Get("PRIVATE-TOKEN"))
+		switch r.URL.RawPath {
+		case "/api/v4/projects/group%2Fproject/issues/12":
+			_, _ = w.Write([]byte(`{"title":"Crash on start","state":"closed","web_url":"https://gitlab.com/group/project/-/issues/12","labels":["bug"],"issue_type":"incident"}`))
+		case "/api/v4/projects/group%2Fproject/merge_requests/3":
+			_, _ = w.Write([]byte(`{"title":"Fix crash","state":"merged","web_url":"https://gitlab.com/group/project/-/merge_requests/3"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	tracker := NewGitLabIssueTracker(server.URL+"/api/v4", "group/project", "secret")
+
+	issue, err := tracker.GetIssue(&Ref{Ref: "12", Kind: RefKindIssue})
+	assert.Nil(err)
+	assert.Equal(&Issue{
+		ID:     "12",
+		Title:  "Crash on start",
+		Type:   "incident",
+		Labels: []string{"bug"},
+		URL:    "https://gitlab.com/group/project/-/issues/12",
+		State:  "closed",
+	}, issue)
+
+	issue, err = tracker.GetIssue(&Ref{Ref: "3", Kind: RefKindPullRequest})
+	assert.Nil(err)
+	assert.Equal("merged", issue.State)
+	assert.Equal([]string{}, issue.Labels)
+}
+
+func TestLinearIssueTracker(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(http.MethodPost, r.Method)
+		assert.Equal("/graphql", r.URL.Path)
+		assert.Equal("lin_api_secret", r.Header.Get("Authorization"))
+
+		var req struct {
+			Variables map[string]string `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		switch req.Variables["id"] {
+		case "ENG-1":
+			_, _ = w.Write([]byte(`{"data":{"issue":{"title":"Crash on start","url":"https://linear.app/team/issue/ENG-1/crash-on-start","state":{"name":"Done"},"labels":{"nodes":[{"name":"Bug"}]}}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"Entity not found: Issue"}]}`))
+		}
+	})
+	defer server.Close()
+
+	tracker := NewLinearIssueTracker(server.URL, "lin_api_secret")
+
+	issue, err := tracker.GetIssue(&Ref{Ref: "ENG-1", Kind: RefKindExternal})
+	assert.Nil(err)
+	assert.Equal(&Issue{
+		ID:     "ENG-1",
+		Title:  "Crash on start",
+		Labels: []string{"Bug"},
+		URL:    "https://linear.app/team/issue/ENG-1/crash-on-start",
+		State:  "Done",
+	}, issue)
+
+	_, err = tracker.GetIssue(&Ref{Ref: "ENG-9", Kind: RefKindExternal})
+	assert.EqualError(err, "Entity not found: Issue")
+
+	issue, err = tracker.GetIssue(&Ref{Ref: "1", Kind: RefKindIssue})
+	assert.Nil(err)
+	assert.Nil(issue)
+}
+
+func TestYouTrackIssueTracker(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("Bearer perm:secret", r.Header.Get("Authorization"))
+		switch r.URL.Path {
+		case "/api/issues/PROJ-1":
+			assert.Equal("summary,tags(name),customFields(name,value(name))", r.URL.Query().Get("fields"))
+			_, _ = w.Write([]byte(`{"summary":"Crash on start","tags":[{"name":"regression"}],"customFields":[{"name":"Type","value":{"name":"Bug"}},{"name":"State","value":{"name":"Fixed"}},{"name":"Assignees","value":[{"name":"alice"}]},{"name":"Estimation","value":null}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	defer server.Close()
+
+	tracker := NewYouTrackIssueTracker(server.URL+"/", "perm:secret")
+
+	issue, err := tracker.GetIssue(&Ref{Ref: "PROJ-1", Kind: RefKindExternal})
+	assert.Nil(err)
+	assert.Equal(&Issue{
+		ID:     "PROJ-1",
+		Title:  "Crash on start",
+		Type:   "Bug",
+		Labels: []string{"regression"},
+		URL:    server.URL + "/issue/PROJ-1",
+		State:  "Fixed",
+	}, issue)
+
+	_, err = tracker.GetIssue(&Ref{Ref: "PROJ-9", Kind: RefKindExternal})
+	assert.ErrorContains(err, "status 404")
+}
+
+func TestJiraIssueTracker(t *testing.T) {
+	assert := assert.New(t)
+
+	tracker := NewJiraIssueTracker(mockJiraClient{}, "https://jira.example.com/")
+
+	issue, err := tracker.GetIssue(&Ref{Ref: "PROJ-1", Kind: RefKindExternal})
+	assert.Nil(err)
+	assert.Equal(&Issue{
+		ID:     "PROJ-1",
+		Title:  "summary of PROJ-1",
+		Type:   "Story",
+		Labels: []string{"GA"},
+		URL:    "https://jira.example.com/browse/PROJ-1",
+	}, issue)
+
+	issue, err = tracker.GetIssue(&Ref{Ref: "1", Kind: RefKindIssue})
+	assert.Nil(err)
+	assert.Nil(issue)
+}
+
+type mockIssueTracker struct {
+	calls map[string]int
+}
+
+func (t *mockIssueTracker) GetIssue(ref *Ref) (*Issue, error) {
+	t.calls[ref.Ref]++
+	if ref.Ref == "123" {
+		return nil, errors.New("not found")
+	}
+	return &Issue{ID: ref.Ref, Title: "title of " + ref.Ref}, nil
+}
+
+func TestCommitParserParseWithIssueTracker(t *testing.T) {
+	assert := assert.New(t)
+
+	mock := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			if subcmd != "log" {
+				return "", errors.New("")
+			}
+
+			bytes, _ := os.ReadFile(filepath.Join("testdata", "gitlog.txt"))
+
+			return string(bytes), nil
+		},
+	}
+
+	tracker := &mockIssueTracker{calls: map[string]int{}}
+	parser := newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true), mock, nil, &Config{
+		Options: &Options{
+			HeaderPattern:     "^(.*)$",
+			HeaderPatternMaps: []string{"Subject"},
+			IssuePrefix:       []string{"#"},
+			RefActions:        []string{"Closes", "Fixes"},
+			IssueTracker:      tracker,
+		},
+	})
+
+	commits, err := parser.Parse("HEAD")
+	assert.Nil(err)
+
+	refs := 0
+	for _, commit := range commits {
+		for _, ref := range commit.Refs {
+			refs++
+			if ref.Ref == "123" {
+				assert.Nil(ref.Issue)
+			} else {
+				assert.Equal("title of "+ref.Ref, ref.Issue.Title)
+			}
+		}
+	}
+
+	assert.NotZero(refs)
+	for ref, calls := range tracker.calls {
+		assert.Equal(1, calls, ref)
+	}
+}
+
+func TestCommitParserParseWithSharedJiraIssueTracker(t *testing.T) {
+	assert := assert.New(t)
+
+	mock := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			if subcmd != "log" {
+				return "", errors.New("")
+			}
+
+			bytes, _ := os.ReadFile(filepath.Join("testdata", "gitlog_jira_keys.txt"))
+
+			return string(bytes), nil
+		},
+	}
+
+	jira := &countingJiraClient{calls: map[string]int{}}
+	parser := newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true), mock, jira, &Config{
+		Options: &Options{
+			HeaderPattern:       "^(\\w*)\\:\\s(.*)$",
+			HeaderPatternMaps:   []string{"Type", "Subject"},
+			RefActions:          []string{"Closes"},
+			JiraURL:             "https://jira.example.com",
+			JiraIssueKeyPattern: "PROJ|OPS",
+			IssueTracker:        NewJiraIssueTracker(nil, "https://jira.example.com"),
+		},
+	})
+
+	commits, err := parser.Parse("HEAD")
+	assert.Nil(err)
+
+	var ref *Ref
+	for _, r := range commits[1].Refs {
+		if r.Kind == RefKindExternal {
+			ref = r
+		}
+	}
+	assert.NotNil(ref)
+	assert.Equal(&Issue{
+		ID:     "PROJ-2",
+		Title:  "summary of PROJ-2",
+		Type:   "Story",
+		Labels: []string{"GA"},
+		URL:    "https://jira.example.com/browse/PROJ-2",
+	}, ref.Issue)
+	assert.Equal(1, jira.calls["PROJ-2"])
+}
+
+func TestCommitParserParseRefsWithIssueKeyPattern(t *testing.T) {
+	assert := assert.New(t)
+
+	newParser := func(tracker IssueTracker) *commitParser {
+		return newCommitParser(NewLogger(os.Stdout, os.Stderr, false, true), nil, nil, &Config{
+			Options: &Options{
+				HeaderPattern:   "^(.*)$",
+				IssuePrefix:     []string{"#"},
+				RefActions:      []string{"Closes", "Fixes"},
+				IssueKeyPattern: "ENG",
+				IssueTracker:    tracker,
+			},
+		})
+	}
+
+	parser := newParser(NewLinearIssueTracker("https://api.linear.app", ""))
+	assert.Equal([]*Ref{
+		{Action: "Closes", Ref: "12", Kind: RefKindIssue},
+		{Action: "Fixes", Ref: "ENG-34", Kind: RefKindExternal},
+	}, parser.parseRefs("Closes #12, Fixes ENG-34, fixes UTF-8 and closes OPS-5"))
+
+	// the keys are for the tracker only
+	assert.Equal([]*Ref{}, newParser(nil).parseRefs("Fixes ENG-34"))
+}
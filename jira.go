@@ -1,6 +1,12 @@
 package chglog
 
 import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
 	agjira "github.com/andygrunwald/go-jira"
 )
 
@@ -9,30 +15,148 @@ type JiraClient interface {
 	GetJiraIssue(id string) (*agjira.Issue, error)
 }
 
+// Authentication types of Jira
+const (
+	JiraAuthBasic  = "basic"  // Username and API token (Jira Cloud) or password
+	JiraAuthBearer = "bearer" // Personal access token (Jira Data Center / Server)
+	JiraAuthOAuth1 = "oauth1" // OAuth 1.0a with an RSA-SHA1 signed application link
+)
+
+// Precedences of the commit type over the mapped Jira type
+const (
+	JiraTypePrecedenceJira   = "jira"   // The mapped Jira type replaces the type of the commit
+	JiraTypePrecedenceCommit = "commit" // The type of the commit is kept, the mapped Jira type only fills in a missing one
+)
+
+const (
+	defaultJiraTimeout    = 30 * time.Second
+	defaultJiraMaxRetries = 3
+)
+
 type jiraClient struct {
-	username string
-	token    string
-	url      string
+	client     *agjira.Client
+	err        error // error of the configuration, reported by every call
+	maxRetries int
+	backoff    time.Duration
+	maxWait    time.Duration // cap of `Retry-After`, a proxy may ask for an hour
+	sleep      func(time.Duration)
+
+	mu      sync.Mutex
+	authErr error // authentication failure, the following calls fail fast
 }
 
-// NewJiraClient returns an instance of JiraClient
+// NewJiraClient returns an instance of JiraClient.
+// The HTTP client is shared by the calls, requests time out and are retried with backoff on 429 and 5xx.
+// If `JiraExport` is set, the issues are read from the export instead.
 func NewJiraClient(config *Config) JiraClient {
-	return jiraClient{
-		username: config.Options.JiraUsername,
-		token:    config.Options.JiraToken,
-		url:      config.Options.JiraURL,
+	opts := config.Options
+
+	if opts.JiraExport != "" {
+		return newJiraFileClient(opts.JiraExport)
 	}
-}
 
-func (jira jiraClient) GetJiraIssue(id string) (*agjira.Issue, error) {
-	tp := agjira.BasicAuthTransport{
-		Username: jira.username,
-		Password: jira.token,
+	auth, err := newJiraAuthTransport(opts)
+	if err != nil {
+		return &jiraClient{err: err}
+	}
+
+	timeout := opts.JiraTimeout
+	if timeout <= 0 {
+		timeout = defaultJiraTimeout
+	}
+
+	maxRetries := opts.JiraMaxRetries
+	if maxRetries == 0 {
+		maxRetries = defaultJiraMaxRetries
 	}
-	client, err := agjira.NewClient(tp.Client(), jira.url)
+
+	client, err := agjira.NewClient(&http.Client{Timeout: timeout, Transport: auth}, opts.JiraURL)
 	if err != nil {
-		return nil, err
+		return &jiraClient{err: fmt.Errorf("invalid Jira URL \"%s\": %w", opts.JiraURL, err)}
+	}
+
+	return &jiraClient{
+		client:     client,
+		maxRetries: maxRetries,
+		backoff:    500 * time.Millisecond,
+		maxWait:    timeout,
+		sleep:      time.Sleep,
+	}
+}
+
+func newJiraAuthTransport(opts *Options) (http.RoundTripper, error) {
+	switch opts.JiraAuthType {
+	case "", JiraAuthBasic:
+		return &agjira.BasicAuthTransport{
+			Username: opts.JiraUsername,
+			Password: opts.JiraToken,
+		}, nil
+	case JiraAuthBearer:
+		return &agjira.BearerAuthTransport{
+			Token: opts.JiraToken,
+		}, nil
+	case JiraAuthOAuth1:
+		return newJiraOAuth1Transport(opts.JiraOAuthConsumerKey, opts.JiraOAuthPrivateKeyFile, opts.JiraToken)
+	default:
+		return nil, fmt.Errorf("\"%s\" is not a supported Jira auth type, use \"%s\", \"%s\" or \"%s\"", opts.JiraAuthType, JiraAuthBasic, JiraAuthBearer, JiraAuthOAuth1)
+	}
+}
+
+func (jira *jiraClient) GetJiraIssue(id string) (*agjira.Issue, error) {
+	if jira.err != nil {
+		return nil, jira.err
+	}
+
+	jira.mu.Lock()
+	authErr := jira.authErr
+	jira.mu.Unlock()
+	if authErr != nil {
+		return nil, authErr
+	}
+
+	for attempt := 0; ; attempt++ {
+		issue, res, err := jira.client.Issue.Get(id, nil)
+		if err == nil {
+			return issue, nil
+		}
+
+		if res == nil || attempt >= jira.maxRetries || !jira.retryable(res.StatusCode) {
+			return nil, jira.wrapError(id, res, err)
+		}
+
+		jira.sleep(jira.retryWait(res, attempt))
+	}
+}
+
+func (*jiraClient) retryable(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// retryWait honors `Retry-After` up to the timeout of a request, otherwise the wait doubles on each attempt
+func (jira *jiraClient) retryWait(res *agjira.Response, attempt int) time.Duration {
+	if seconds, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, jira.maxWait)
+	}
+	return jira.backoff << attempt
+}
+
+func (jira *jiraClient) wrapError(id string, res *agjira.Response, err error) error {
+	if res == nil {
+		return fmt.Errorf("failed to request Jira issue %s: %w", id, err)
+	}
+
+	switch res.StatusCode {
+	case http.StatusUnauthorized:
+		err = fmt.Errorf("failed to authenticate to Jira, check the auth type and credentials: %w", err)
+		jira.mu.Lock()
+		jira.authErr = err
+		jira.mu.Unlock()
+		return err
+	case http.StatusForbidden:
+		return fmt.Errorf("access to Jira issue %s is forbidden: %w", id, err)
+	case http.StatusNotFound:
+		return fmt.Errorf("issue %s does not exist in Jira or is not visible: %w", id, err)
+	default:
+		return fmt.Errorf("failed to get Jira issue %s (status %d): %w", id, res.StatusCode, err)
 	}
-	issue, _, err := client.Issue.Get(id, nil)
-	return issue, err
 }
@@ -0,0 +1,74 @@
+package chglog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	agjira "github.com/andygrunwald/go-jira"
+)
+
+// extractJiraFields returns the Jira fields named by `JiraFields` (name -> field ID, e.g. `Epic: customfield_10014`).
+// Objects are reduced to their name (e.g. priority, status), a multi-valued field becomes a `[]string`
+// and other values a `string`, empty fields are omitted.
+func extractJiraFields(fields *agjira.IssueFields, ids map[string]string) map[string]interface{} {
+	extracted := map[string]interface{}{}
+	if fields == nil || len(ids) == 0 {
+		return extracted
+	}
+
+	// marshals the known fields and the custom fields under their Jira IDs
+	raw := map[string]interface{}{}
+	if bytes, err := json.Marshal(fields); err == nil {
+		_ = json.Unmarshal(bytes, &raw)
+	}
+
+	for name, id := range ids {
+		if value := jiraFieldValue(raw[id]); value != nil {
+			extracted[name] = value
+		}
+	}
+
+	return extracted
+}
+
+func jiraFieldValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		values := []string{}
+		for _, item := range v {
+			if s, ok := jiraFieldValue(item).(string); ok {
+				values = append(values, s)
+			}
+		}
+		if len(values) == 0 {
+			return nil
+		}
+		return values
+	case map[string]interface{}:
+		for _, key := range []string{"name", "value", "displayName", "key"} {
+			if s, ok := v[key].(string); ok && s != "" {
+				return s
+			}
+		}
+		return nil
+	case string:
+		if v == "" {
+			return nil
+		}
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case nil:
+		return nil
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func jiraStatus(fields *agjira.IssueFields) string {
+	if fields.Status == nil {
+		return ""
+	}
+	return fields.Status.Name
+}
@@ -0,0 +1,46 @@
+package chglog
+
+import (
+	"testing"
+
+	agjira "github.com/andygrunwald/go-jira"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractJiraFields(t *testing.T) {
+	assert := assert.New(t)
+
+	fields := &agjira.IssueFields{
+		Priority:    &agjira.Priority{Name: "High"},
+		Status:      &agjira.Status{Name: "Done"},
+		Components:  []*agjira.Component{{Name: "api"}, {Name: "cli"}},
+		FixVersions: []*agjira.FixVersion{{Name: "1.2.0"}},
+		Unknowns: map[string]interface{}{
+			"customfield_10014": "PROJ-5",
+			"customfield_10016": 8.0,
+			"customfield_10020": map[string]interface{}{"id": "10001", "value": "Backend"},
+		},
+	}
+
+	assert.Equal(map[string]interface{}{
+		"Epic":        "PROJ-5",
+		"StoryPoints": "8",
+		"Team":        "Backend",
+		"Priority":    "High",
+		"Status":      "Done",
+		"Components":  []string{"api", "cli"},
+		"FixVersions": []string{"1.2.0"},
+	}, extractJiraFields(fields, map[string]string{
+		"Epic":        "customfield_10014",
+		"StoryPoints": "customfield_10016",
+		"Team":        "customfield_10020",
+		"Priority":    "priority",
+		"Status":      "status",
+		"Components":  "components",
+		"FixVersions": "fixVersions",
+		"Sprint":      "customfield_10099",
+		"Labels":      "labels",
+	}))
+
+	assert.Equal(map[string]interface{}{}, extractJiraFields(fields, nil))
+}
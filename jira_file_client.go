@@ -0,0 +1,208 @@
+package chglog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	agjira "github.com/andygrunwald/go-jira"
+)
+
+// jiraFileClient reads Jira issues from an export instead of requesting Jira, for networks without access to it.
+// The export is either a JSON file (an issue, a list of issues or a search result of the REST API),
+// a CSV file exported from the issue navigator, or a directory of JSON files. It is loaded on the first call.
+type jiraFileClient struct {
+	path   string
+	once   sync.Once
+	issues map[string]*agjira.Issue
+	err    error
+}
+
+func newJiraFileClient(path string) *jiraFileClient {
+	return &jiraFileClient{path: path}
+}
+
+func (c *jiraFileClient) GetJiraIssue(id string) (*agjira.Issue, error) {
+	c.once.Do(func() {
+		c.issues = map[string]*agjira.Issue{}
+		c.err = c.load()
+	})
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	issue, ok := c.issues[id]
+	if !ok {
+		return nil, fmt.Errorf("issue %s does not exist in the Jira export \"%s\"", id, c.path)
+	}
+
+	return issue, nil
+}
+
+func (c *jiraFileClient) load() error {
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return fmt.Errorf("failed to read the Jira export: %w", err)
+	}
+
+	if !info.IsDir() {
+		return c.loadFile(c.path)
+	}
+
+	files, err := filepath.Glob(filepath.Join(c.path, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if err = c.loadFile(file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *jiraFileClient) loadFile(path string) error {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return fmt.Errorf("failed to read the Jira export: %w", err)
+	}
+
+	var issues []*agjira.Issue
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		issues, err = parseJiraCSV(string(bytes))
+	} else {
+		issues, err = parseJiraJSON(bytes)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to parse the Jira export \"%s\": %w", path, err)
+	}
+
+	for _, issue := range issues {
+		if issue == nil || issue.Key == "" {
+			continue
+		}
+		// an issue exported without fields is kept with empty ones, like those of the REST API
+		if issue.Fields == nil {
+			issue.Fields = &agjira.IssueFields{}
+		}
+		c.issues[issue.Key] = issue
+	}
+
+	return nil
+}
+
+// parseJiraJSON parses an issue, a list of issues or a search result (`{"issues": [...]}`)
+func parseJiraJSON(bytes []byte) ([]*agjira.Issue, error) {
+	issues := []*agjira.Issue{}
+
+	data := strings.TrimSpace(string(bytes))
+	if strings.HasPrefix(data, "[") {
+		err := json.Unmarshal(bytes, &issues)
+		return issues, err
+	}
+
+	obj := map[string]json.RawMessage{}
+	if err := json.Unmarshal(bytes, &obj); err != nil {
+		return nil, err
+	}
+
+	if raw, ok := obj["issues"]; ok {
+		err := json.Unmarshal(raw, &issues)
+		return issues, err
+	}
+
+	issue := &agjira.Issue{}
+	if err := json.Unmarshal(bytes, issue); err != nil {
+		return nil, err
+	}
+
+	return append(issues, issue), nil
+}
+
+// jiraCSVColumns assigns the columns of a CSV export, the names of Jira Cloud and Jira Server are both supported.
+// Multi-valued fields are exported as repeated columns.
+var jiraCSVColumns = map[string]func(issue *agjira.Issue, value string){
+	"issue key":   func(issue *agjira.Issue, value string) { issue.Key = value },
+	"issue id":    func(issue *agjira.Issue, value string) { issue.ID = value },
+	"summary":     func(issue *agjira.Issue, value string) { issue.Fields.Summary = value },
+	"description": func(issue *agjira.Issue, value string) { issue.Fields.Description = value },
+	"issue type":  func(issue *agjira.Issue, value string) { issue.Fields.Type.Name = value },
+	"priority":    func(issue *agjira.Issue, value string) { issue.Fields.Priority = &agjira.Priority{Name: value} },
+	"status":      func(issue *agjira.Issue, value string) { issue.Fields.Status = &agjira.Status{Name: value} },
+	"labels":      func(issue *agjira.Issue, value string) { issue.Fields.Labels = append(issue.Fields.Labels, value) },
+	"fix version/s": func(issue *agjira.Issue, value string) {
+		issue.Fields.FixVersions = append(issue.Fields.FixVersions, &agjira.FixVersion{Name: value})
+	},
+	"fix versions": func(issue *agjira.Issue, value string) {
+		issue.Fields.FixVersions = append(issue.Fields.FixVersions, &agjira.FixVersion{Name: value})
+	},
+	"component/s": func(issue *agjira.Issue, value string) {
+		issue.Fields.Components = append(issue.Fields.Components, &agjira.Component{Name: value})
+	},
+	"components": func(issue *agjira.Issue, value string) {
+		issue.Fields.Components = append(issue.Fields.Components, &agjira.Component{Name: value})
+	},
+}
+
+// parseJiraCSV parses a CSV export, other columns are kept as custom fields named by their header
+// (e.g. `Custom field (Epic Link)`). A repeated column is a list for every issue, even with a single value.
+func parseJiraCSV(data string) ([]*agjira.Issue, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = -1
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("the header is missing")
+	}
+
+	header := records[0]
+	issues := []*agjira.Issue{}
+
+	columns := map[string]int{}
+	for _, name := range header {
+		columns[strings.TrimSpace(name)]++
+	}
+
+	for _, record := range records[1:] {
+		issue := &agjira.Issue{Fields: &agjira.IssueFields{Unknowns: map[string]interface{}{}}}
+
+		for i, value := range record {
+			if i >= len(header) || value == "" {
+				continue
+			}
+
+			name := strings.TrimSpace(header[i])
+			if assign, ok := jiraCSVColumns[strings.ToLower(name)]; ok {
+				assign(issue, value)
+				continue
+			}
+
+			addJiraCSVCustomField(issue.Fields.Unknowns, name, value, columns[name] > 1)
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues, nil
+}
+
+func addJiraCSVCustomField(fields map[string]interface{}, name, value string, repeated bool) {
+	if !repeated {
+		fields[name] = value
+		return
+	}
+
+	values, _ := fields[name].([]interface{})
+	fields[name] = append(values, value)
+}
@@ -0,0 +1,126 @@
+package chglog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJiraFileClientJSON(t *testing.T) {
+	assert := assert.New(t)
+
+	dir := t.TempDir()
+	_ = os.WriteFile(filepath.Join(dir, "PROJ-1.json"), []byte(`{"key":"PROJ-1","fields":{"summary":"summary of PROJ-1","issuetype":{"name":"Story"},"customfield_10014":"PROJ-5"}}`), 0600)
+	_ = os.WriteFile(filepath.Join(dir, "search.json"), []byte(`{"startAt":0,"total":2,"issues":[{"key":"PROJ-2","fields":{"summary":"summary of PROJ-2"}},{"key":"PROJ-3","fields":{"summary":"summary of PROJ-3"}}]}`), 0600)
+	_ = os.WriteFile(filepath.Join(dir, "list.json"), []byte(`[{"key":"PROJ-4","fields":{"summary":"summary of PROJ-4","labels":["GA"]}}]`), 0600)
+	_ = os.WriteFile(filepath.Join(dir, "nofields.json"), []byte(`{"issues":[{"key":"PROJ-6"}]}`), 0600)
+	_ = os.WriteFile(filepath.Join(dir, "README.md"), []byte(`not an export`), 0600)
+
+	jira := NewJiraClient(&Config{
+		Options: &Options{
+			JiraExport: dir,
+		},
+	})
+
+	issue, err := jira.GetJiraIssue("PROJ-1")
+	assert.Nil(err)
+	assert.Equal("Story", issue.Fields.Type.Name)
+	assert.Equal("PROJ-5", issue.Fields.Unknowns["customfield_10014"])
+
+	for _, key := range []string{"PROJ-2", "PROJ-3", "PROJ-4"} {
+		issue, err = jira.GetJiraIssue(key)
+		assert.Nil(err)
+		assert.Equal("summary of "+key, issue.Fields.Summary)
+	}
+
+	// an issue without fields
+	issue, err = jira.GetJiraIssue("PROJ-6")
+	assert.Nil(err)
+	assert.NotNil(issue.Fields)
+	assert.Equal("", jiraStatus(issue.Fields))
+
+	parser := newCommitParser(NewLogger(os.Stdout, os.Stderr, true, true), &mockClient{}, jira, &Config{
+		Options: &Options{JiraExport: dir},
+	})
+	assert.Equal("PROJ-6", parser.getJiraIssue("PROJ-6").Key)
+	assert.Empty(parser.problems)
+
+	issue, err = jira.GetJiraIssue("PROJ-9")
+	assert.Nil(issue)
+	assert.EqualError(err, "issue PROJ-9 does not exist in the Jira export \""+dir+"\"")
+
+	// a single file
+	jira = NewJiraClient(&Config{
+		Options: &Options{
+			JiraExport: filepath.Join(dir, "list.json"),
+		},
+	})
+
+	issue, err = jira.GetJiraIssue("PROJ-4")
+	assert.Nil(err)
+	assert.Equal([]string{"GA"}, issue.Fields.Labels)
+
+	// missing export
+	jira = NewJiraClient(&Config{
+		Options: &Options{
+			JiraExport: filepath.Join(dir, "missing.json"),
+		},
+	})
+
+	_, err = jira.GetJiraIssue("PROJ-4")
+	assert.ErrorContains(err, "failed to read the Jira export")
+}
+
+func TestJiraFileClientCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	file := filepath.Join(t.TempDir(), "jira.csv")
+	_ = os.WriteFile(file, []byte(`Summary,Issue key,Issue id,Issue Type,Status,Priority,Labels,Labels,Fix Version/s,Component/s,Component/s,Custom field (Epic Link),Custom field (Team),Custom field (Team),Description
+Add login,PROJ-1,10001,Story,Done,High,GA,auth,1.0,api,cli,PROJ-5,Backend,Web,"Multi
+line"
+Fix login,PROJ-2,10002,Bug,In Progress,,,,,,,,Backend,,
+`), 0600)
+
+	jira := NewJiraClient(&Config{
+		Options: &Options{
+			JiraExport: file,
+		},
+	})
+
+	issue, err := jira.GetJiraIssue("PROJ-1")
+	assert.Nil(err)
+	assert.Equal("10001", issue.ID)
+	assert.Equal("Add login", issue.Fields.Summary)
+	assert.Equal("Multi\nline", issue.Fields.Description)
+	assert.Equal("Story", issue.Fields.Type.Name)
+	assert.Equal("Done", issue.Fields.Status.Name)
+	assert.Equal("High", issue.Fields.Priority.Name)
+	assert.Equal([]string{"GA", "auth"}, issue.Fields.Labels)
+	assert.Equal("1.0", issue.Fields.FixVersions[0].Name)
+
+	// fields are extracted like the ones of the REST API
+	assert.Equal(map[string]interface{}{
+		"Epic":       "PROJ-5",
+		"Components": []string{"api", "cli"},
+		"Status":     "Done",
+	}, extractJiraFields(issue.Fields, map[string]string{
+		"Epic":       "Custom field (Epic Link)",
+		"Components": "components",
+		"Status":     "status",
+	}))
+
+	issue, err = jira.GetJiraIssue("PROJ-2")
+	assert.Nil(err)
+	assert.Equal("Bug", issue.Fields.Type.Name)
+	assert.Nil(issue.Fields.Priority)
+	assert.Empty(issue.Fields.Labels)
+
+	// a repeated column is a list even with a single value, so that it can be compared with the others
+	assert.Equal(map[string]interface{}{
+		"Team": []string{"Backend"},
+	}, extractJiraFields(issue.Fields, map[string]string{
+		"Team": "Custom field (Team)",
+	}))
+}
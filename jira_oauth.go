@@ -0,0 +1,164 @@
+package chglog
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// jiraOAuth1Transport signs requests with OAuth 1.0a (RSA-SHA1) as required by the application links of Jira
+type jiraOAuth1Transport struct {
+	consumerKey string
+	accessToken string
+	privateKey  *rsa.PrivateKey
+	now         func() time.Time
+	transport   http.RoundTripper
+}
+
+func newJiraOAuth1Transport(consumerKey string, privateKeyFile string, accessToken string) (*jiraOAuth1Transport, error) {
+	if consumerKey == "" || privateKeyFile == "" || accessToken == "" {
+		return nil, errors.New("OAuth 1.0a of Jira requires a consumer key, a private key file and an access token")
+	}
+
+	bytes, err := os.ReadFile(filepath.Clean(privateKeyFile))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the private key of Jira: %w", err)
+	}
+
+	key, err := parseRSAPrivateKey(bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key of Jira \"%s\": %w", privateKeyFile, err)
+	}
+
+	return &jiraOAuth1Transport{
+		consumerKey: consumerKey,
+		accessToken: accessToken,
+		privateKey:  key,
+		now:         time.Now,
+		transport:   http.DefaultTransport,
+	}, nil
+}
+
+func parseRSAPrivateKey(bytes []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(bytes)
+	if block == nil {
+		return nil, errors.New("no PEM data is found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("not an RSA private key")
+	}
+
+	return rsaKey, nil
+}
+
+// RoundTrip ...
+func (t *jiraOAuth1Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{
+		"oauth_consumer_key":     t.consumerKey,
+		"oauth_token":            t.accessToken,
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(t.now().Unix(), 10),
+		"oauth_nonce":            hex.EncodeToString(nonce),
+		"oauth_version":          "1.0",
+	}
+
+	signature, err := t.sign(req, params)
+	if err != nil {
+		return nil, err
+	}
+	params["oauth_signature"] = signature
+
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		pairs[i] = fmt.Sprintf("%s=\"%s\"", key, oauthEscape(params[key]))
+	}
+
+	r := req.Clone(req.Context())
+	r.Header.Set("Authorization", "OAuth "+strings.Join(pairs, ", "))
+
+	return t.transport.RoundTrip(r)
+}
+
+// sign computes the signature over the base string of the request (RFC 5849, section 3.4.1)
+func (t *jiraOAuth1Transport) sign(req *http.Request, params map[string]string) (string, error) {
+	pairs := []string{}
+	for key, values := range req.URL.Query() {
+		for _, value := range values {
+			pairs = append(pairs, oauthEscape(key)+"="+oauthEscape(value))
+		}
+	}
+	for key, value := range params {
+		pairs = append(pairs, oauthEscape(key)+"="+oauthEscape(value))
+	}
+	sort.Strings(pairs)
+
+	base := url.URL{
+		Scheme: strings.ToLower(req.URL.Scheme),
+		Host:   strings.ToLower(req.URL.Host),
+		Path:   req.URL.EscapedPath(),
+	}
+
+	baseString := strings.Join([]string{
+		strings.ToUpper(req.Method),
+		oauthEscape(base.Scheme + "://" + base.Host + base.Path),
+		oauthEscape(strings.Join(pairs, "&")),
+	}, "&")
+
+	digest := sha1.Sum([]byte(baseString)) //nolint:gosec
+	signature, err := rsa.SignPKCS1v15(rand.Reader, t.privateKey, crypto.SHA1, digest[:])
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// oauthEscape percent-encodes all but the unreserved characters of RFC 3986
+func oauthEscape(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || strings.IndexByte("-._~", c) > -1 {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
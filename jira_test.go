@@ -1,7 +1,20 @@
 package chglog
 
 import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1" //nolint:gosec
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -41,3 +54,214 @@ func TestJira(t *testing.T) {
 	assert.Nil(issue)
 	assert.Error(err)
 }
+
+func newTestJiraServer(handler func(w http.ResponseWriter, r *http.Request)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(handler))
+}
+
+func TestJiraBearerAuth(t *testing.T) {
+	assert := assert.New(t)
+
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/rest/api/2/issue/JIRA-1", r.URL.Path)
+		assert.Equal("Bearer ppp", r.Header.Get("Authorization"))
+		_, _ = w.Write([]byte(`{"key":"JIRA-1","fields":{"summary":"summary of JIRA-1"}}`))
+	})
+	defer server.Close()
+
+	jira := NewJiraClient(&Config{
+		Options: &Options{
+			JiraAuthType: JiraAuthBearer,
+			JiraToken:    "ppp",
+			JiraURL:      server.URL,
+		},
+	})
+
+	issue, err := jira.GetJiraIssue("JIRA-1")
+	assert.Nil(err)
+	assert.Equal("summary of JIRA-1", issue.Fields.Summary)
+}
+
+func TestJiraRetry(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch {
+		case requests == 1:
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case requests == 2 || requests < 0:
+			w.WriteHeader(http.StatusBadGateway)
+		default:
+			_, _ = w.Write([]byte(`{"key":"JIRA-1","fields":{"summary":"summary of JIRA-1"}}`))
+		}
+	})
+	defer server.Close()
+
+	jira := NewJiraClient(&Config{
+		Options: &Options{
+			JiraURL: server.URL,
+		},
+	}).(*jiraClient)
+
+	waits := []time.Duration{}
+	jira.sleep = func(d time.Duration) {
+		waits = append(waits, d)
+	}
+
+	issue, err := jira.GetJiraIssue("JIRA-1")
+	assert.Nil(err)
+	assert.Equal("summary of JIRA-1", issue.Fields.Summary)
+	assert.Equal(3, requests)
+	assert.Equal([]time.Duration{7 * time.Second, time.Second}, waits)
+
+	// gives up
+	requests = -10
+	issue, err = jira.GetJiraIssue("JIRA-1")
+	assert.Nil(issue)
+	assert.ErrorContains(err, "status 502")
+	assert.Equal(-6, requests)
+}
+
+func TestJiraRetryAfterCap(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"key":"JIRA-1","fields":{"summary":"summary of JIRA-1"}}`))
+	})
+	defer server.Close()
+
+	jira := NewJiraClient(&Config{
+		Options: &Options{
+			JiraURL:     server.URL,
+			JiraTimeout: 5 * time.Second,
+		},
+	}).(*jiraClient)
+
+	waits := []time.Duration{}
+	jira.sleep = func(d time.Duration) {
+		waits = append(waits, d)
+	}
+
+	_, err := jira.GetJiraIssue("JIRA-1")
+	assert.Nil(err)
+	assert.Equal([]time.Duration{5 * time.Second}, waits)
+}
+
+func TestJiraErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/rest/api/2/issue/JIRA-404" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	defer server.Close()
+
+	jira := NewJiraClient(&Config{
+		Options: &Options{
+			JiraURL: server.URL,
+		},
+	})
+
+	_, err := jira.GetJiraIssue("JIRA-404")
+	assert.ErrorContains(err, "issue JIRA-404 does not exist in Jira")
+
+	// authentication failure is reported once
+	_, err = jira.GetJiraIssue("JIRA-1")
+	assert.ErrorContains(err, "failed to authenticate to Jira")
+	_, err = jira.GetJiraIssue("JIRA-2")
+	assert.ErrorContains(err, "failed to authenticate to Jira")
+	assert.Equal(2, requests)
+
+	// configuration
+	jira = NewJiraClient(&Config{
+		Options: &Options{
+			JiraAuthType: "digest",
+			JiraURL:      server.URL,
+		},
+	})
+
+	_, err = jira.GetJiraIssue("JIRA-1")
+	assert.EqualError(err, "\"digest\" is not a supported Jira auth type, use \"basic\", \"bearer\" or \"oauth1\"")
+
+	jira = NewJiraClient(&Config{
+		Options: &Options{
+			JiraAuthType: JiraAuthOAuth1,
+			JiraURL:      server.URL,
+		},
+	})
+
+	_, err = jira.GetJiraIssue("JIRA-1")
+	assert.Error(err)
+	assert.Equal(2, requests)
+}
+
+func TestJiraOAuth1(t *testing.T) {
+	assert := assert.New(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(err)
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "jira.pem")
+	assert.Nil(os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	}), 0600))
+
+	server := newTestJiraServer(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		assert.True(strings.HasPrefix(auth, "OAuth "))
+		assert.Contains(auth, `oauth_consumer_key="chglog"`)
+		assert.Contains(auth, `oauth_token="access"`)
+		assert.Contains(auth, `oauth_signature_method="RSA-SHA1"`)
+		assert.Contains(auth, `oauth_signature="`)
+		_, _ = w.Write([]byte(`{"key":"JIRA-1","fields":{"summary":"summary of JIRA-1"}}`))
+	})
+	defer server.Close()
+
+	jira := NewJiraClient(&Config{
+		Options: &Options{
+			JiraAuthType:            JiraAuthOAuth1,
+			JiraOAuthConsumerKey:    "chglog",
+			JiraOAuthPrivateKeyFile: keyFile,
+			JiraToken:               "access",
+			JiraURL:                 server.URL,
+		},
+	})
+
+	issue, err := jira.GetJiraIssue("JIRA-1")
+	assert.Nil(err)
+	assert.Equal("summary of JIRA-1", issue.Fields.Summary)
+}
+
+func TestJiraOAuth1Signature(t *testing.T) {
+	assert := assert.New(t)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(err)
+
+	transport := &jiraOAuth1Transport{privateKey: key}
+	req, _ := http.NewRequest("GET", "https://Jira.example.com/rest/api/2/issue/JIRA-1?fields=summary%2Clabels", nil)
+
+	signature, err := transport.sign(req, map[string]string{"oauth_nonce": "abc"})
+	assert.Nil(err)
+
+	digest := sha1.Sum([]byte("GET&https%3A%2F%2Fjira.example.com%2Frest%2Fapi%2F2%2Fissue%2FJIRA-1&fields%3Dsummary%252Clabels%26oauth_nonce%3Dabc")) //nolint:gosec
+	bytes, _ := base64.StdEncoding.DecodeString(signature)
+	assert.Nil(rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA1, digest[:], bytes))
+}
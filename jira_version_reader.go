@@ -0,0 +1,118 @@
+package chglog
+
+import (
+	"sort"
+	"time"
+
+	agjira "github.com/andygrunwald/go-jira"
+)
+
+// newJiraVersions converts the fix versions of a Jira issue, release dates are formatted as `2006-01-02`
+func newJiraVersions(fixVersions []*agjira.FixVersion) []*JiraVersion {
+	versions := []*JiraVersion{}
+
+	for _, v := range fixVersions {
+		if v == nil || v.Name == "" {
+			continue
+		}
+
+		version := &JiraVersion{
+			Name:        v.Name,
+			Description: v.Description,
+			Released:    v.Released != nil && *v.Released,
+		}
+		if date, err := time.ParseInLocation("2006-01-02", v.ReleaseDate, time.Local); err == nil {
+			version.ReleaseDate = date
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions
+}
+
+// jiraVersionReader synthesizes `Tag` from the Jira fix versions of the issues referenced by commits.
+// A commit belongs to every fix version of its issues, a commit whose issues have no fix version is unreleased
+// and a commit without Jira issue is skipped.
+type jiraVersionReader struct{}
+
+func newJiraVersionReader() *jiraVersionReader {
+	return &jiraVersionReader{}
+}
+
+// Read returns the tags sorted from the newest, the commits of each tag name and the unreleased commits
+func (r *jiraVersionReader) Read(commits []*Commit) ([]*Tag, map[string][]*Commit, []*Commit) {
+	tags := []*Tag{}
+	released := map[string]bool{}
+	assigned := map[string][]*Commit{}
+	unreleased := []*Commit{}
+
+	for _, commit := range commits {
+		if commit == nil || len(commit.JiraIssues) == 0 {
+			continue
+		}
+
+		versions := r.fixVersions(commit)
+		if len(versions) == 0 {
+			unreleased = append(unreleased, commit)
+			continue
+		}
+
+		for _, v := range versions {
+			if _, ok := assigned[v.Name]; !ok {
+				tags = append(tags, r.newTag(v, commit))
+				released[v.Name] = v.Released
+			}
+			assigned[v.Name] = append(assigned[v.Name], commit)
+		}
+	}
+
+	// versions to be released first, then by release date
+	sort.SliceStable(tags, func(i, j int) bool {
+		if released[tags[i].Name] != released[tags[j].Name] {
+			return !released[tags[i].Name]
+		}
+		return tags[i].Date.After(tags[j].Date)
+	})
+	assignPreviousAndNextTag(tags)
+
+	return tags, assigned, unreleased
+}
+
+// fixVersions returns the fix versions of the Jira issues of a commit, without duplicates
+func (*jiraVersionReader) fixVersions(commit *Commit) []*JiraVersion {
+	versions := []*JiraVersion{}
+	seen := map[string]bool{}
+
+	for _, issue := range commit.JiraIssues {
+		for _, v := range issue.FixVersions {
+			if !seen[v.Name] {
+				seen[v.Name] = true
+				versions = append(versions, v)
+			}
+		}
+	}
+
+	return versions
+}
+
+// newTag dates the tag with the release date, or with the newest commit if the release date is not set
+func (*jiraVersionReader) newTag(v *JiraVersion, newest *Commit) *Tag {
+	date := v.ReleaseDate
+	if date.IsZero() && newest.Author != nil {
+		date = newest.Author.Date
+	}
+
+	subject := v.Description
+	if subject == "" {
+		subject = v.Name
+	}
+
+	return &Tag{
+		Name:        v.Name,
+		DisplayName: v.Name,
+		Version:     v.Name,
+		Subject:     subject,
+		Date:        date,
+	}
+}
@@ -0,0 +1,295 @@
+package chglog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+)
+
+// LintProblem is a commit breaking the conventions of the configuration
+type LintProblem struct {
+	Hash       *Hash  // `nil` for a message linted with `LintMessage`
+	Header     string // (e.g. `Feat: add login`)
+	Message    string // (e.g. `type "Feat" is not allowed, use one of: feat, fix`)
+	Suggestion string // Empty if there is no close fix (e.g. `did you mean "feat"?`)
+}
+
+// scissors of `git commit --verbose`, the diff below it is not part of the message
+const lintScissors = "# ------------------------ >8 ------------------------"
+
+var (
+	reLintAutosquash = regexp.MustCompile(`^(fixup|squash|amend)! `)
+	reLintNoteLike   = regexp.MustCompile(`^\s*([A-Za-z][\w -]{2,30}):\s`)
+	reLintHeaderSep  = regexp.MustCompile(`^([^\s:]+):\s*`)
+)
+
+// Linter validates commit headers against `HeaderPattern`, and their types and scopes against the allowed ones.
+// The allowed types are `LintTypes`, or else those of the `Type` filter and of `CommitGroupTitleMaps` when
+// grouping by `Type`. The allowed scopes are `LintScopes`. Without them, any type or scope is accepted.
+// Merge and revert commits are not linted.
+// A message linted with `LintMessage` is also checked for lines looking like a misspelled `NoteKeywords`.
+type Linter struct {
+	client       gitcmd.Client
+	config       *Config
+	commitParser *commitParser
+	reHeader     *regexp.Regexp
+	reMerge      *regexp.Regexp
+	reRevert     *regexp.Regexp
+	types        []string
+	scopes       []string
+	noteKeywords []string
+}
+
+// NewLinter receives `Config` and creates a new `Linter`, Jira and issue trackers are not requested
+func NewLinter(logger *Logger, config *Config) *Linter {
+	client := gitcmd.New(&gitcmd.Config{
+		Bin: config.Bin,
+	})
+
+	// only the header and the body are of interest
+	opts := *config.Options
+	opts.Processor = nil
+	opts.Processors = nil
+	opts.IssueTracker = nil
+	opts.JiraURL = ""
+	opts.JiraExport = ""
+
+	lintConfig := *config
+	lintConfig.Options = &opts
+	normalizeConfig(&lintConfig)
+
+	return &Linter{
+		client:       client,
+		config:       &lintConfig,
+		commitParser: newCommitParser(logger, client, nil, &lintConfig),
+		reHeader:     regexp.MustCompile(opts.HeaderPattern),
+		reMerge:      regexp.MustCompile(opts.MergePattern),
+		reRevert:     regexp.MustCompile(opts.RevertPattern),
+		types:        lintTypes(&opts),
+		scopes:       opts.LintScopes,
+		noteKeywords: opts.NoteKeywords,
+	}
+}
+
+func lintTypes(opts *Options) []string {
+	if len(opts.LintTypes) > 0 {
+		return opts.LintTypes
+	}
+
+	types := append([]string{}, opts.CommitFilters["Type"]...)
+	if strings.EqualFold(opts.CommitGroupBy, "Type") {
+		for t := range opts.CommitGroupTitleMaps {
+			types = append(types, t)
+		}
+	}
+
+	return uniqSortedStrings(types)
+}
+
+// Lint validates the commits of a revision range (e.g. `origin/main..HEAD`)
+func (l *Linter) Lint(rev string) ([]*LintProblem, error) {
+	back, err := changeDir(l.config.WorkingDir)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = back()
+	}()
+
+	commits, err := l.commitParser.Parse(rev)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the commits of \"%s\": %w", rev, err)
+	}
+
+	problems := []*LintProblem{}
+	for _, commit := range commits {
+		problems = append(problems, l.lintCommit(commit)...)
+	}
+
+	return problems, nil
+}
+
+// LintMessage validates a single commit message (e.g. the file given to a `commit-msg` hook).
+// Comment lines and the diff of `git commit --verbose` are ignored, as are `fixup!`, `squash!` and
+// `amend!` messages which are folded into another commit.
+func (l *Linter) LintMessage(message string) []*LintProblem {
+	message = cleanCommitMessage(message)
+	if message == "" || reLintAutosquash.MatchString(message) {
+		return []*LintProblem{}
+	}
+
+	commit := l.commitParser.parseMessage(message)
+	problems := l.lintCommit(commit)
+
+	if commit.Merge == nil && commit.Revert == nil {
+		problems = append(problems, l.lintNotes(commit)...)
+	}
+
+	return problems
+}
+
+func cleanCommitMessage(message string) string {
+	lines := []string{}
+	for _, line := range strings.Split(convNewline(message, "\n"), "\n") {
+		if line == lintScissors {
+			break
+		}
+		if !strings.HasPrefix(line, "#") {
+			lines = append(lines, strings.TrimRight(line, " \t"))
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func (l *Linter) lintCommit(commit *Commit) []*LintProblem {
+	if l.reMerge.MatchString(commit.Header) || l.reRevert.MatchString(commit.Header) {
+		return nil
+	}
+
+	problems := []*LintProblem{}
+	report := func(suggestion, format string, args ...interface{}) {
+		problems = append(problems, &LintProblem{
+			Hash:       commit.Hash,
+			Header:     commit.Header,
+			Message:    fmt.Sprintf(format, args...),
+			Suggestion: suggestion,
+		})
+	}
+
+	if !l.reHeader.MatchString(commit.Header) {
+		report(l.suggestHeader(commit.Header), "header does not match the pattern \"%s\"", l.config.Options.HeaderPattern)
+		return problems
+	}
+
+	if len(l.types) > 0 && !l.allowed(l.types, commit.Type) {
+		report(suggest(l.types, commit.Type), "type \"%s\" is not allowed, use one of: %s", commit.Type, strings.Join(l.types, ", "))
+	}
+
+	if len(l.scopes) > 0 && commit.Scope != "" && !l.allowed(l.scopes, commit.Scope) {
+		report(suggest(l.scopes, commit.Scope), "scope \"%s\" is not allowed, use one of: %s", commit.Scope, strings.Join(l.scopes, ", "))
+	}
+
+	return problems
+}
+
+// lintNotes reports the body lines looking like a note whose keyword is misspelled (e.g. `BREAKING CHANGES:`)
+func (l *Linter) lintNotes(commit *Commit) []*LintProblem {
+	problems := []*LintProblem{}
+	fenceDetector := newMdFenceDetector()
+
+	for _, line := range strings.Split(commit.Body, "\n") {
+		fenceDetector.Update(line)
+		if fenceDetector.InCodeblock() || l.commitParser.reNotes.MatchString(line) {
+			continue
+		}
+
+		res := reLintNoteLike.FindStringSubmatch(line)
+		if res == nil {
+			continue
+		}
+
+		keyword := closest(l.noteKeywords, strings.ToUpper(res[1]))
+		if keyword == "" {
+			continue
+		}
+
+		problems = append(problems, &LintProblem{
+			Header:     commit.Header,
+			Message:    fmt.Sprintf("\"%s\" is not a note keyword, the note is not recognized", res[1]),
+			Suggestion: fmt.Sprintf("did you mean \"%s:\"?", keyword),
+		})
+	}
+
+	return problems
+}
+
+// suggestHeader tries the usual fixes of a header (e.g. `Feat:add` to `feat: add`)
+func (l *Linter) suggestHeader(header string) string {
+	if header == "" {
+		return ""
+	}
+
+	spaced := reLintHeaderSep.ReplaceAllString(header, "$1: ")
+	candidates := []string{
+		spaced,
+		strings.ToLower(spaced[:1]) + spaced[1:],
+	}
+	if len(l.types) > 0 {
+		candidates = append(candidates, l.types[0]+": "+header)
+	}
+
+	for _, candidate := range candidates {
+		if candidate != header && l.reHeader.MatchString(candidate) {
+			return fmt.Sprintf("did you mean \"%s\"?", candidate)
+		}
+	}
+
+	return ""
+}
+
+func (l *Linter) allowed(list []string, value string) bool {
+	for _, s := range list {
+		if s == value || (l.config.Options.NoCaseSensitive && strings.EqualFold(s, value)) {
+			return true
+		}
+	}
+	return false
+}
+
+func suggest(list []string, value string) string {
+	if s := closest(list, value); s != "" {
+		return fmt.Sprintf("did you mean \"%s\"?", s)
+	}
+	return ""
+}
+
+// closest returns the string of the list closest to the value, or an empty string if none is close enough
+func closest(list []string, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	for _, s := range list {
+		if strings.EqualFold(s, value) {
+			return s
+		}
+	}
+
+	lower := strings.ToLower(value)
+	for _, s := range list {
+		ls := strings.ToLower(s)
+		if len(ls) >= 3 && len(lower) >= 3 && (strings.HasPrefix(lower, ls) || strings.HasPrefix(ls, lower)) {
+			return s
+		}
+	}
+
+	res := ""
+	best := 0
+	for _, s := range list {
+		d := levenshtein(strings.ToLower(s), lower)
+		if d <= max(1, len(s)/3) && (res == "" || d < best) {
+			res = s
+			best = d
+		}
+	}
+
+	return res
+}
+
+func uniqSortedStrings(list []string) []string {
+	res := []string{}
+	seen := map[string]bool{}
+
+	for _, s := range list {
+		if !seen[s] {
+			seen[s] = true
+			res = append(res, s)
+		}
+	}
+	sort.Strings(res)
+
+	return res
+}
@@ -0,0 +1,171 @@
+package chglog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+)
+
+func TestLinter(t *testing.T) {
+	assert := assert.New(t)
+	testName := "linter"
+
+	setup(testName, func(commit commitFunc, tag tagFunc, _ gitcmd.Client) {
+		commit("2018-01-01 00:00:00", "feat(core): Initial commit", "")
+		tag("1.0.0")
+		commit("2018-01-02 00:00:00", "fix(core): Fix bug", "")
+		commit("2018-01-03 00:00:00", "Feat(core): Add feature", "")
+		commit("2018-01-04 00:00:00", "docs(readme): Update readme", "")
+		commit("2018-01-05 00:00:00", "Update dependencies", "")
+		commit("2018-01-06 00:00:00", "Revert \"Update dependencies\"", "")
+		commit("2018-01-07 00:00:00", "perf: Speed up", "")
+	})
+
+	newLinter := func(opts *Options) *Linter {
+		opts.HeaderPattern = "^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$"
+		opts.HeaderPatternMaps = []string{"Type", "Scope", "Subject"}
+
+		return NewLinter(NewLogger(os.Stdout, os.Stderr, true, true), &Config{
+			Bin:        "git",
+			WorkingDir: filepath.Join(testRepoRoot, testName),
+			Options:    opts,
+		})
+	}
+
+	messages := func(problems []*LintProblem) []string {
+		res := []string{}
+		for _, p := range problems {
+			res = append(res, p.Header+": "+p.Message)
+		}
+		return res
+	}
+
+	// types of the filter and the title maps
+	linter := newLinter(&Options{
+		CommitFilters: map[string][]string{
+			"Type": {"feat", "fix"},
+		},
+		CommitGroupBy: "Type",
+		CommitGroupTitleMaps: map[string]string{
+			"perf": "Performance Improvements",
+		},
+		LintScopes: []string{"core"},
+	})
+
+	problems, err := linter.Lint("1.0.0..HEAD")
+	assert.Nil(err)
+	assert.Equal([]string{
+		"Update dependencies: header does not match the pattern \"^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$\"",
+		"docs(readme): Update readme: type \"docs\" is not allowed, use one of: feat, fix, perf",
+		"docs(readme): Update readme: scope \"readme\" is not allowed, use one of: core",
+		"Feat(core): Add feature: type \"Feat\" is not allowed, use one of: feat, fix, perf",
+	}, messages(problems))
+	assert.NotEmpty(problems[0].Hash.Short)
+
+	// explicit types, case insensitive
+	linter = newLinter(&Options{
+		LintTypes:       []string{"feat", "fix", "docs", "perf"},
+		NoCaseSensitive: true,
+	})
+
+	problems, err = linter.Lint("1.0.0..HEAD")
+	assert.Nil(err)
+	assert.Equal([]string{
+		"Update dependencies: header does not match the pattern \"^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$\"",
+	}, messages(problems))
+
+	_, err = linter.Lint("0.0.0..HEAD")
+	assert.Error(err)
+}
+
+func TestLinterLintMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	linter := NewLinter(NewLogger(os.Stdout, os.Stderr, true, true), &Config{
+		Bin: "git",
+		Options: &Options{
+			HeaderPattern:     "^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$",
+			HeaderPatternMaps: []string{"Type", "Scope", "Subject"},
+			NoteKeywords:      []string{"BREAKING CHANGE"},
+			LintTypes:         []string{"feat", "fix", "refactor"},
+			LintScopes:        []string{"core", "ui"},
+		},
+	})
+
+	table := []struct {
+		message  string
+		expected [][]string
+	}{
+		{
+			message:  "feat(core): Add login\n\nBody\n\nBREAKING CHANGE: The API changed\n# Please enter the commit message\n",
+			expected: [][]string{},
+		},
+		{
+			message:  "\n# comment only\n",
+			expected: [][]string{},
+		},
+		{
+			message:  "fixup! WIP",
+			expected: [][]string{},
+		},
+		{
+			message:  "Merge branch 'feature'",
+			expected: [][]string{},
+		},
+		{
+			message: "Feat(core): Add login",
+			expected: [][]string{
+				{"type \"Feat\" is not allowed, use one of: feat, fix, refactor", "did you mean \"feat\"?"},
+			},
+		},
+		{
+			message: "feature(uii): Add login",
+			expected: [][]string{
+				{"type \"feature\" is not allowed, use one of: feat, fix, refactor", "did you mean \"feat\"?"},
+				{"scope \"uii\" is not allowed, use one of: core, ui", "did you mean \"ui\"?"},
+			},
+		},
+		{
+			message: "refactr: Clean up",
+			expected: [][]string{
+				{"type \"refactr\" is not allowed, use one of: feat, fix, refactor", "did you mean \"refactor\"?"},
+			},
+		},
+		{
+			message: "docs: Update",
+			expected: [][]string{
+				{"type \"docs\" is not allowed, use one of: feat, fix, refactor", ""},
+			},
+		},
+		{
+			message: "fix:Handle errors",
+			expected: [][]string{
+				{"header does not match the pattern \"^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$\"", "did you mean \"fix: Handle errors\"?"},
+			},
+		},
+		{
+			message: "Handle errors",
+			expected: [][]string{
+				{"header does not match the pattern \"^(\\w*)(?:\\(([\\w\\$\\.\\-\\*\\s]*)\\))?\\:\\s(.*)$\"", "did you mean \"feat: Handle errors\"?"},
+			},
+		},
+		{
+			message: "feat: Add login\n\nBREAKING CHANGES: The API changed\n\n```\nBreaking-Change: in a code block\n```\n\n# ------------------------ >8 ------------------------\nBreaking-Change: in the diff",
+			expected: [][]string{
+				{"\"BREAKING CHANGES\" is not a note keyword, the note is not recognized", "did you mean \"BREAKING CHANGE:\"?"},
+			},
+		},
+	}
+
+	for _, v := range table {
+		actual := [][]string{}
+		for _, p := range linter.LintMessage(v.message) {
+			assert.Nil(p.Hash)
+			actual = append(actual, []string{p.Message, p.Suggestion})
+		}
+		assert.Equal(v.expected, actual, v.message)
+	}
+}
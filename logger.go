@@ -43,6 +43,12 @@ func (l *Logger) Error(msg string) {
 	l.log(l.stderr, fmt.Sprintf("%s %s\n", prefix(" ERROR "), color.RedString(msg)))
 }
 
+// Warn ...
+func (l *Logger) Warn(msg string) {
+	prefix := color.New(color.FgBlack, color.BgYellow, color.Bold).SprintFunc()
+	l.log(l.stderr, fmt.Sprintf("%s %s\n", prefix(" WARN "), color.YellowString(msg)))
+}
+
 func (l *Logger) log(w io.Writer, msg string) {
 	var printer func(io.Writer, ...interface{}) (int, error)
 
@@ -73,3 +73,17 @@ func TestLoggerError(t *testing.T) {
 	assert.Equal("", stdout.String())
 	assert.NotContains(stderr.String(), emoji.Sprint(":hand:"))
 }
+
+func TestLoggerWarn(t *testing.T) {
+	color.NoColor = false
+	assert := assert.New(t)
+
+	prefix := color.New(color.FgBlack, color.BgYellow, color.Bold).SprintFunc()
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	logger := NewLogger(stdout, stderr, true, false)
+	logger.Warn("This is warning message!!")
+	assert.Equal("", stdout.String())
+	assert.Equal(fmt.Sprintf("%s %s\n", prefix(" WARN "), color.YellowString("This is warning message!!")), stderr.String())
+}
@@ -0,0 +1,102 @@
+package chglog
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+)
+
+// mailmap normalizes contacts according to `.mailmap` with git-check-mailmap, results are cached
+type mailmap struct {
+	client    gitcmd.Client
+	reContact *regexp.Regexp
+	cache     map[string]Contact
+}
+
+func newMailmap(client gitcmd.Client) *mailmap {
+	return &mailmap{
+		client:    client,
+		reContact: regexp.MustCompile(`^\s*(.*?)\s*<([^>]+)>\s*$`),
+		cache:     map[string]Contact{},
+	}
+}
+
+// Resolve returns the canonical contacts in the same order.
+// It is best effort, the contacts are kept as is if git-check-mailmap fails (e.g. outside of a repository).
+func (m *mailmap) Resolve(contacts []Contact) []Contact {
+	pending := []string{}
+	queued := map[string]bool{}
+
+	for _, c := range contacts {
+		key := m.format(c)
+		if _, ok := m.cache[key]; ok || queued[key] {
+			continue
+		}
+		queued[key] = true
+		pending = append(pending, key)
+	}
+
+	// keep the command line reasonably short
+	const chunk = 500
+
+	for start := 0; start < len(pending); start += chunk {
+		end := start + chunk
+		if end > len(pending) {
+			end = len(pending)
+		}
+
+		out, err := m.client.Exec("check-mailmap", pending[start:end]...)
+		lines := []string{}
+		if err == nil {
+			lines = strings.Split(strings.TrimRight(out, "\n"), "\n")
+		}
+
+		for i, key := range pending[start:end] {
+			contact := Contact{}
+			if i < len(lines) {
+				contact = m.parse(lines[i])
+			}
+			if contact.Email == "" {
+				contact = m.parse(key)
+			}
+			m.cache[key] = contact
+		}
+	}
+
+	resolved := make([]Contact, len(contacts))
+	for i, c := range contacts {
+		resolved[i] = m.cache[m.format(c)]
+		resolved[i].Username = c.Username
+	}
+
+	return resolved
+}
+
+func (*mailmap) format(c Contact) string {
+	if c.Name == "" {
+		return fmt.Sprintf("<%s>", c.Email)
+	}
+	return fmt.Sprintf("%s <%s>", c.Name, c.Email)
+}
+
+func (m *mailmap) parse(s string) Contact {
+	if res := m.reContact.FindStringSubmatch(s); len(res) > 2 {
+		return Contact{Name: res[1], Email: res[2]}
+	}
+	return Contact{}
+}
+
+// lookupAlias returns the username aliased to the email, matched case-insensitively
+func lookupAlias(aliases map[string]string, email string) string {
+	if username, ok := aliases[email]; ok {
+		return username
+	}
+	for key, username := range aliases {
+		if strings.EqualFold(key, email) {
+			return username
+		}
+	}
+	return ""
+}
@@ -11,59 +11,244 @@ type Processor interface {
 	ProcessCommit(*Commit) *Commit
 }
 
-// GitHubProcessor is optimized for CHANGELOG used in GitHub
+// VersionProcessor is an optional interface of `Processor`.
+// `ProcessVersion` is called for each `Version` after its commits are extracted, e.g. to add computed sections.
+// It is not called for `Unreleased`, which is only passed to `ProcessRenderData`.
+type VersionProcessor interface {
+	ProcessVersion(*Version)
+}
+
+// RenderDataProcessor is an optional interface of `Processor`.
+// `ProcessRenderData` is called right before rendering, e.g. to reorder or filter `Versions` or adjust `Unreleased`.
+// `VersionGroups` is computed afterwards from the processed `Versions`.
+type RenderDataProcessor interface {
+	ProcessRenderData(*RenderData)
+}
+
+// processors returns the processors to apply in order, the deprecated `Processor` comes first
+func (opts *Options) processors() []Processor {
+	if opts.Processor == nil {
+		return opts.Processors
+	}
+	return append([]Processor{opts.Processor}, opts.Processors...)
+}
+
+// LinkRule is a rule to link the text matched by `Pattern`
 //
-// The following processing is performed
-//   - Mentions automatic link (@tsuyoshiwada -> [@tsuyoshiwada](https://github.com/tsuyoshiwada))
-//   - Automatic link to references (#123 -> [#123](https://github.com/owner/repo/issues/123))
-type GitHubProcessor struct {
-	Host      string // Host name used for link destination. Note: You must include the protocol (e.g. "https://github.com")
-	config    *Config
-	reMention *regexp.Regexp
-	reIssue   *regexp.Regexp
+// `URL` and `Text` are expanded with the submatches of `Pattern` (e.g. `$1`, `${name}`),
+// `{host}` is replaced with the host of the processor and `{repository_url}` with `Info.RepositoryURL`
+type LinkRule struct {
+	Pattern string // A regular expression to find the text to link (e.g. `PROJ-(\d+)`)
+	URL     string // Link destination (e.g. `https://tracker.example.com/browse/PROJ-$1`)
+	Text    string // Link text. If not specified, the matched text is used
 }
 
-// Bootstrap ...
-func (p *GitHubProcessor) Bootstrap(config *Config) {
-	p.config = config
+type linkRule struct {
+	re          *regexp.Regexp
+	replacement string
+}
 
-	if p.Host == "" {
-		p.Host = "https://github.com"
-	} else {
-		p.Host = strings.TrimRight(p.Host, "/")
+// reSourceRef matches the repository qualifying a reference (e.g. `owner/repo` of `owner/repo#12`)
+const reSourceRef = `\b([\w.\-]+(?:/[\w.\-]+)+)`
+
+// reMdProtected matches markdown that must be kept as is: inline code, existing links,
+// autolinks, URLs and email addresses
+var reMdProtected = regexp.MustCompile("`[^`]*`" +
+	`|!?\[[^\]]*\]\([^)]*\)` +
+	`|<[^>\s]+>` +
+	`|[a-zA-Z][a-zA-Z0-9+.\-]*://[^\s<>()]+` +
+	`|[\w.+\-]+@[\w\-]+(?:\.[\w\-]+)+`)
+
+// link replaces the matches outside of the protected markdown of the line
+func (r *linkRule) link(line string) string {
+	var b strings.Builder
+	last := 0
+
+	for _, loc := range reMdProtected.FindAllStringIndex(line, -1) {
+		b.WriteString(r.re.ReplaceAllString(line[last:loc[0]], r.replacement))
+		b.WriteString(line[loc[0]:loc[1]])
+		last = loc[1]
 	}
+	b.WriteString(r.re.ReplaceAllString(line[last:], r.replacement))
 
-	p.reMention = regexp.MustCompile(`@(\w+)`)
-	p.reIssue = regexp.MustCompile(`(?i)(#|gh-)(\d+)`)
+	return b.String()
 }
 
-// ProcessCommit ...
-func (p *GitHubProcessor) ProcessCommit(commit *Commit) *Commit {
-	commit.Header = p.addLinks(commit.Header)
-	commit.Subject = p.addLinks(commit.Subject)
-	commit.Body = p.addLinks(commit.Body)
+type refURL struct {
+	url       string
+	sourceURL string
+}
+
+type linker struct {
+	placeholders *strings.Replacer
+	rules        []*linkRule
+	refURLs      map[string]*refURL
+}
+
+func newLinker(host string, repoURL string, rules []LinkRule) *linker {
+	placeholders := strings.NewReplacer(
+		"{host}", host,
+		"{repository_url}", strings.TrimRight(repoURL, "/"),
+	)
+
+	l := &linker{
+		placeholders: placeholders,
+		rules:        make([]*linkRule, len(rules)),
+		refURLs:      map[string]*refURL{},
+	}
+
+	for i, rule := range rules {
+		text := rule.Text
+		if text == "" {
+			text = "${0}"
+		}
+
+		l.rules[i] = &linkRule{
+			re:          regexp.MustCompile(rule.Pattern),
+			replacement: "[" + placeholders.Replace(text) + "](" + placeholders.Replace(rule.URL) + ")",
+		}
+	}
+
+	return l
+}
+
+// RefURLs sets the formats of `Ref.URL` for the kind of refs. `{ref}` is replaced with `Ref.Ref` and
+// `{source}` with `Ref.Source`, `sourceURL` is used for the refs of another repository (e.g. `owner/repo#12`)
+func (l *linker) RefURLs(kind string, url string, sourceURL string) *linker {
+	l.refURLs[kind] = &refURL{
+		url:       l.placeholders.Replace(url),
+		sourceURL: l.placeholders.Replace(sourceURL),
+	}
+	return l
+}
+
+func (l *linker) refURL(ref *Ref) string {
+	kind := ref.Kind
+	if kind == "" {
+		kind = RefKindIssue
+	}
+
+	formats, ok := l.refURLs[kind]
+	if !ok {
+		return ""
+	}
+
+	format := formats.url
+	if ref.Source != "" {
+		// only `owner/repo` is qualified enough to tell the repository
+		if !strings.Contains(ref.Source, "/") {
+			return ""
+		}
+		format = formats.sourceURL
+	}
+
+	return strings.NewReplacer(
+		"{ref}", ref.Ref,
+		"{source}", strings.Trim(ref.Source, "/"),
+	).Replace(format)
+}
+
+// Link applies the rules in order, skipping fenced code blocks.
+// The links made by a rule are protected from the following rules.
+func (l *linker) Link(input string) string {
+	fenceDetector := newMdCodeFenceDetector()
+	lines := strings.Split(input, "\n")
+
+	for i, line := range lines {
+		fenceDetector.Update(line)
+		if fenceDetector.InCodeblock() {
+			continue
+		}
+
+		for _, rule := range l.rules {
+			lines[i] = rule.link(lines[i])
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// LinkCommit applies the rules to the texts of the commit intended to be rendered
+func (l *linker) LinkCommit(commit *Commit) *Commit {
+	commit.Header = l.Link(commit.Header)
+	commit.Subject = l.Link(commit.Subject)
+	commit.Body = l.Link(commit.Body)
 
 	for _, note := range commit.Notes {
-		note.Body = p.addLinks(note.Body)
+		note.Body = l.Link(note.Body)
 	}
 
 	if commit.Revert != nil {
-		commit.Revert.Header = p.addLinks(commit.Revert.Header)
+		commit.Revert.Header = l.Link(commit.Revert.Header)
+	}
+
+	// a former processor of the chain takes precedence
+	for _, ref := range commit.Refs {
+		if ref.URL == "" {
+			ref.URL = l.refURL(ref)
+		}
 	}
 
 	return commit
 }
 
-func (p *GitHubProcessor) addLinks(input string) string {
-	repoURL := strings.TrimRight(p.config.Info.RepositoryURL, "/")
+// LinkProcessor links the text of commits according to the configured rules.
+// It is intended for forges and trackers without a dedicated processor (e.g. Gerrit, internal trackers)
+//
+// The following processing is performed
+//   - Automatic link by rules (PROJ-123 -> [PROJ-123](https://tracker.example.com/browse/PROJ-123))
+type LinkProcessor struct {
+	Host   string // Host name used for `{host}`. Note: You must include the protocol (e.g. "https://git.example.com")
+	Rules  []LinkRule
+	linker *linker
+}
+
+// Bootstrap ...
+func (p *LinkProcessor) Bootstrap(config *Config) {
+	p.Host = strings.TrimRight(p.Host, "/")
+	p.linker = newLinker(p.Host, config.Info.RepositoryURL, p.Rules)
+}
+
+// ProcessCommit ...
+func (p *LinkProcessor) ProcessCommit(commit *Commit) *Commit {
+	return p.linker.LinkCommit(commit)
+}
+
+// GitHubProcessor is optimized for CHANGELOG used in GitHub
+//
+// The following processing is performed
+//   - Mentions automatic link (@tsuyoshiwada -> [@tsuyoshiwada](https://github.com/tsuyoshiwada))
+//   - Automatic link to references (#123 -> [#123](https://github.com/owner/repo/issues/123))
+//   - Automatic link to references of other repositories (owner/other#123 -> [owner/other#123](https://github.com/owner/other/issues/123))
+//   - `Ref.URL` of references
+type GitHubProcessor struct {
+	Host   string // Host name used for link destination. Note: You must include the protocol (e.g. "https://github.com")
+	linker *linker
+}
 
-	// mentions
-	input = p.reMention.ReplaceAllString(input, "[@$1]("+p.Host+"/$1)")
+// Bootstrap ...
+func (p *GitHubProcessor) Bootstrap(config *Config) {
+	if p.Host == "" {
+		p.Host = "https://github.com"
+	} else {
+		p.Host = strings.TrimRight(p.Host, "/")
+	}
 
-	// issues
-	input = p.reIssue.ReplaceAllString(input, "[$1$2]("+repoURL+"/issues/$2)")
+	p.linker = newLinker(p.Host, config.Info.RepositoryURL, []LinkRule{
+		// mentions
+		{Pattern: `@(\w+)`, Text: "@${1}", URL: "{host}/${1}"},
+		// issues of other repositories
+		{Pattern: reSourceRef + `#(\d+)`, Text: "${1}#${2}", URL: "{host}/${1}/issues/${2}"},
+		// issues
+		{Pattern: `(?i)(#|gh-)(\d+)`, Text: "${1}${2}", URL: "{repository_url}/issues/${2}"},
+	}).
+		RefURLs(RefKindIssue, "{repository_url}/issues/{ref}", "{host}/{source}/issues/{ref}").
+		RefURLs(RefKindPullRequest, "{repository_url}/pull/{ref}", "{host}/{source}/pull/{ref}")
+}
 
-	return input
+// ProcessCommit ...
+func (p *GitHubProcessor) ProcessCommit(commit *Commit) *Commit {
+	return p.linker.LinkCommit(commit)
 }
 
 // GitLabProcessor is optimized for CHANGELOG used in GitLab
@@ -72,59 +257,39 @@ func (p *GitHubProcessor) addLinks(input string) string {
 //   - Mentions automatic link (@tsuyoshiwada -> [@tsuyoshiwada](https://gitlab.com/tsuyoshiwada))
 //   - Automatic link to references issues (#123 -> [#123](https://gitlab.com/owner/repo/issues/123))
 //   - Automatic link to references merge request (!123 -> [#123](https://gitlab.com/owner/repo/merge_requests/123))
+//   - Automatic link to references of other projects (group/other#123 -> [group/other#123](https://gitlab.com/group/other/issues/123))
+//   - `Ref.URL` of references
 type GitLabProcessor struct {
-	Host           string // Host name used for link destination. Note: You must include the protocol (e.g. "https://gitlab.com")
-	config         *Config
-	reMention      *regexp.Regexp
-	reIssue        *regexp.Regexp
-	reMergeRequest *regexp.Regexp
+	Host   string // Host name used for link destination. Note: You must include the protocol (e.g. "https://gitlab.com")
+	linker *linker
 }
 
 // Bootstrap ...
 func (p *GitLabProcessor) Bootstrap(config *Config) {
-	p.config = config
-
 	if p.Host == "" {
 		p.Host = "https://gitlab.com"
 	} else {
 		p.Host = strings.TrimRight(p.Host, "/")
 	}
 
-	p.reMention = regexp.MustCompile(`@(\w+)`)
-	p.reIssue = regexp.MustCompile(`(?i)#(\d+)`)
-	p.reMergeRequest = regexp.MustCompile(`(?i)!(\d+)`)
+	p.linker = newLinker(p.Host, config.Info.RepositoryURL, []LinkRule{
+		// mentions
+		{Pattern: `@(\w+)`, Text: "@${1}", URL: "{host}/${1}"},
+		// issues and merge requests of other projects
+		{Pattern: reSourceRef + `#(\d+)`, Text: "${1}#${2}", URL: "{host}/${1}/issues/${2}"},
+		{Pattern: reSourceRef + `!(\d+)`, Text: "${1}!${2}", URL: "{host}/${1}/merge_requests/${2}"},
+		// issues
+		{Pattern: `(?i)#(\d+)`, Text: "#${1}", URL: "{repository_url}/issues/${1}"},
+		// merge requests
+		{Pattern: `(?i)!(\d+)`, Text: "!${1}", URL: "{repository_url}/merge_requests/${1}"},
+	}).
+		RefURLs(RefKindIssue, "{repository_url}/issues/{ref}", "{host}/{source}/issues/{ref}").
+		RefURLs(RefKindPullRequest, "{repository_url}/merge_requests/{ref}", "{host}/{source}/merge_requests/{ref}")
 }
 
 // ProcessCommit ...
 func (p *GitLabProcessor) ProcessCommit(commit *Commit) *Commit {
-	commit.Header = p.addLinks(commit.Header)
-	commit.Subject = p.addLinks(commit.Subject)
-	commit.Body = p.addLinks(commit.Body)
-
-	for _, note := range commit.Notes {
-		note.Body = p.addLinks(note.Body)
-	}
-
-	if commit.Revert != nil {
-		commit.Revert.Header = p.addLinks(commit.Revert.Header)
-	}
-
-	return commit
-}
-
-func (p *GitLabProcessor) addLinks(input string) string {
-	repoURL := strings.TrimRight(p.config.Info.RepositoryURL, "/")
-
-	// mentions
-	input = p.reMention.ReplaceAllString(input, "[@$1]("+p.Host+"/$1)")
-
-	// issues
-	input = p.reIssue.ReplaceAllString(input, "[#$1]("+repoURL+"/issues/$1)")
-
-	// merge requests
-	input = p.reMergeRequest.ReplaceAllString(input, "[!$1]("+repoURL+"/merge_requests/$1)")
-
-	return input
+	return p.linker.LinkCommit(commit)
 }
 
 // BitbucketProcessor is optimized for CHANGELOG used in Bitbucket
@@ -132,52 +297,114 @@ func (p *GitLabProcessor) addLinks(input string) string {
 // The following processing is performed
 //   - Mentions automatic link (@tsuyoshiwada -> [@tsuyoshiwada](https://bitbucket.org/tsuyoshiwada/))
 //   - Automatic link to references (#123 -> [#123](https://bitbucket.org/owner/repo/issues/123/))
+//   - Automatic link to references of other repositories (owner/other#123 -> [owner/other#123](https://bitbucket.org/owner/other/issues/123/))
+//   - `Ref.URL` of references
 type BitbucketProcessor struct {
-	Host      string // Host name used for link destination. Note: You must include the protocol (e.g. "https://bitbucket.org")
-	config    *Config
-	reMention *regexp.Regexp
-	reIssue   *regexp.Regexp
+	Host   string // Host name used for link destination. Note: You must include the protocol (e.g. "https://bitbucket.org")
+	linker *linker
 }
 
 // Bootstrap ...
 func (p *BitbucketProcessor) Bootstrap(config *Config) {
-	p.config = config
-
 	if p.Host == "" {
 		p.Host = "https://bitbucket.org"
 	} else {
 		p.Host = strings.TrimRight(p.Host, "/")
 	}
 
-	p.reMention = regexp.MustCompile(`@(\w+)`)
-	p.reIssue = regexp.MustCompile(`(?i)#(\d+)`)
+	p.linker = newLinker(p.Host, config.Info.RepositoryURL, []LinkRule{
+		// mentions
+		{Pattern: `@(\w+)`, Text: "@${1}", URL: "{host}/${1}/"},
+		// issues of other repositories
+		{Pattern: reSourceRef + `#(\d+)`, Text: "${1}#${2}", URL: "{host}/${1}/issues/${2}/"},
+		// issues
+		{Pattern: `(?i)#(\d+)`, Text: "#${1}", URL: "{repository_url}/issues/${1}/"},
+	}).
+		RefURLs(RefKindIssue, "{repository_url}/issues/{ref}/", "{host}/{source}/issues/{ref}/").
+		RefURLs(RefKindPullRequest, "{repository_url}/pull-requests/{ref}", "{host}/{source}/pull-requests/{ref}")
 }
 
 // ProcessCommit ...
 func (p *BitbucketProcessor) ProcessCommit(commit *Commit) *Commit {
-	commit.Header = p.addLinks(commit.Header)
-	commit.Subject = p.addLinks(commit.Subject)
-	commit.Body = p.addLinks(commit.Body)
+	return p.linker.LinkCommit(commit)
+}
 
-	for _, note := range commit.Notes {
-		note.Body = p.addLinks(note.Body)
-	}
+// GiteaProcessor is optimized for CHANGELOG used in Gitea and Forgejo
+//
+// The following processing is performed
+//   - Mentions automatic link (@tsuyoshiwada -> [@tsuyoshiwada](https://gitea.com/tsuyoshiwada))
+//   - Automatic link to references issues (#123 -> [#123](https://gitea.com/owner/repo/issues/123))
+//   - Automatic link to references pull request (!123 -> [!123](https://gitea.com/owner/repo/pulls/123))
+//   - Automatic link to references of other repositories (owner/other#123 -> [owner/other#123](https://gitea.com/owner/other/issues/123))
+//   - `Ref.URL` of references
+type GiteaProcessor struct {
+	Host   string // Host name used for link destination. Note: You must include the protocol (e.g. "https://gitea.com")
+	linker *linker
+}
 
-	if commit.Revert != nil {
-		commit.Revert.Header = p.addLinks(commit.Revert.Header)
+// Bootstrap ...
+func (p *GiteaProcessor) Bootstrap(config *Config) {
+	if p.Host == "" {
+		p.Host = "https://gitea.com"
+	} else {
+		p.Host = strings.TrimRight(p.Host, "/")
 	}
 
-	return commit
+	p.linker = newLinker(p.Host, config.Info.RepositoryURL, []LinkRule{
+		// mentions
+		{Pattern: `@(\w+)`, Text: "@${1}", URL: "{host}/${1}"},
+		// issues of other repositories
+		{Pattern: reSourceRef + `#(\d+)`, Text: "${1}#${2}", URL: "{host}/${1}/issues/${2}"},
+		// issues
+		{Pattern: `(?i)#(\d+)`, Text: "#${1}", URL: "{repository_url}/issues/${1}"},
+		// pull requests
+		{Pattern: `(?i)!(\d+)`, Text: "!${1}", URL: "{repository_url}/pulls/${1}"},
+	}).
+		RefURLs(RefKindIssue, "{repository_url}/issues/{ref}", "{host}/{source}/issues/{ref}").
+		RefURLs(RefKindPullRequest, "{repository_url}/pulls/{ref}", "{host}/{source}/pulls/{ref}")
 }
 
-func (p *BitbucketProcessor) addLinks(input string) string {
-	repoURL := strings.TrimRight(p.config.Info.RepositoryURL, "/")
+// ProcessCommit ...
+func (p *GiteaProcessor) ProcessCommit(commit *Commit) *Commit {
+	return p.linker.LinkCommit(commit)
+}
 
-	// mentions
-	input = p.reMention.ReplaceAllString(input, "[@$1]("+p.Host+"/$1/)")
+// AzureDevOpsProcessor is optimized for CHANGELOG used in Azure DevOps (Azure Repos)
+//
+// The following processing is performed
+//   - Automatic link to references work items (#123 -> [#123](https://dev.azure.com/org/project/_workitems/edit/123))
+//   - Automatic link to references pull request (!123 -> [!123](https://dev.azure.com/org/project/_git/repo/pullrequest/123))
+//   - `Ref.URL` of references to work items and pull requests
+type AzureDevOpsProcessor struct {
+	Host   string // Host name used for link destination. Note: You must include the protocol (e.g. "https://dev.azure.com")
+	linker *linker
+}
 
-	// issues
-	input = p.reIssue.ReplaceAllString(input, "[#$1]("+repoURL+"/issues/$1/)")
+// Bootstrap ...
+func (p *AzureDevOpsProcessor) Bootstrap(config *Config) {
+	if p.Host == "" {
+		p.Host = "https://dev.azure.com"
+	} else {
+		p.Host = strings.TrimRight(p.Host, "/")
+	}
+
+	// Work items belong to the project (e.g. `https://dev.azure.com/org/project/_git/repo` -> `https://dev.azure.com/org/project`)
+	projectURL := strings.TrimRight(config.Info.RepositoryURL, "/")
+	if i := strings.Index(projectURL, "/_git/"); i > -1 {
+		projectURL = projectURL[:i]
+	}
 
-	return input
+	p.linker = newLinker(p.Host, config.Info.RepositoryURL, []LinkRule{
+		// work items
+		{Pattern: `(?i)#(\d+)`, Text: "#${1}", URL: projectURL + "/_workitems/edit/${1}"},
+		// pull requests
+		{Pattern: `(?i)!(\d+)`, Text: "!${1}", URL: "{repository_url}/pullrequest/${1}"},
+	}).
+		RefURLs(RefKindIssue, projectURL+"/_workitems/edit/{ref}", "").
+		RefURLs(RefKindPullRequest, "{repository_url}/pullrequest/{ref}", "")
+}
+
+// ProcessCommit ...
+func (p *AzureDevOpsProcessor) ProcessCommit(commit *Commit) *Commit {
+	return p.linker.LinkCommit(commit)
 }
@@ -195,3 +195,256 @@ gh-56 hoge fuga`,
 		),
 	)
 }
+
+func TestLinkProcessor(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &Config{
+		Info: &Info{
+			RepositoryURL: "https://git.example.com/owner/repo/",
+		},
+	}
+
+	processor := &LinkProcessor{
+		Host: "https://git.example.com/",
+		Rules: []LinkRule{
+			{Pattern: `#(\d+)`, URL: "{repository_url}/issues/$1"},
+			{Pattern: `PROJ-(?P<id>\d+)`, URL: "https://tracker.example.com/browse/PROJ-${id}", Text: "PROJ ${id}"},
+			{Pattern: `@(\w+)`, URL: "{host}/$1"},
+		},
+	}
+
+	processor.Bootstrap(config)
+
+	assert.Equal(
+		&Commit{
+			Header:  "message [#123](https://git.example.com/owner/repo/issues/123) [PROJ 45](https://tracker.example.com/browse/PROJ-45)",
+			Subject: "message [#123](https://git.example.com/owner/repo/issues/123) [PROJ 45](https://tracker.example.com/browse/PROJ-45)",
+			Body:    "thanks [@foo](https://git.example.com/foo)",
+			Notes: []*Note{
+				{
+					Body: "see [PROJ 6](https://tracker.example.com/browse/PROJ-6)",
+				},
+			},
+			Revert: &Revert{
+				Header: "revert [#7](https://git.example.com/owner/repo/issues/7)",
+			},
+		},
+		processor.ProcessCommit(
+			&Commit{
+				Header:  "message #123 PROJ-45",
+				Subject: "message #123 PROJ-45",
+				Body:    "thanks @foo",
+				Notes: []*Note{
+					{
+						Body: "see PROJ-6",
+					},
+				},
+				Revert: &Revert{
+					Header: "revert #7",
+				},
+			},
+		),
+	)
+}
+
+func TestGiteaProcessor(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &Config{
+		Info: &Info{
+			RepositoryURL: "https://example.com",
+		},
+	}
+
+	processor := &GiteaProcessor{}
+
+	processor.Bootstrap(config)
+
+	assert.Equal(
+		&Commit{
+			Header:  "message [@foo](https://gitea.com/foo) [#123](https://example.com/issues/123) [!345](https://example.com/pulls/345)",
+			Subject: "message [@foo](https://gitea.com/foo) [#123](https://example.com/issues/123) [!345](https://example.com/pulls/345)",
+			Body: `issue [#456](https://example.com/issues/456)
+pull request [!345](https://example.com/pulls/345)
+[@foo](https://gitea.com/foo), [@bar](https://gitea.com/bar)`,
+			Notes: []*Note{
+				{
+					Body: `issue1 [#11](https://example.com/issues/11) [!33](https://example.com/pulls/33)`,
+				},
+			},
+			Revert: &Revert{
+				Header: "revert header [@mention](https://gitea.com/mention) [#123](https://example.com/issues/123)",
+			},
+		},
+		processor.ProcessCommit(
+			&Commit{
+				Header:  "message @foo #123 !345",
+				Subject: "message @foo #123 !345",
+				Body: `issue #456
+pull request !345
+@foo, @bar`,
+				Notes: []*Note{
+					{
+						Body: `issue1 #11 !33`,
+					},
+				},
+				Revert: &Revert{
+					Header: "revert header @mention #123",
+				},
+			},
+		),
+	)
+}
+
+func TestAzureDevOpsProcessor(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &Config{
+		Info: &Info{
+			RepositoryURL: "https://dev.azure.com/org/project/_git/repo",
+		},
+	}
+
+	processor := &AzureDevOpsProcessor{}
+
+	processor.Bootstrap(config)
+
+	assert.Equal(
+		&Commit{
+			Header:  "message @foo [#123](https://dev.azure.com/org/project/_workitems/edit/123) [!345](https://dev.azure.com/org/project/_git/repo/pullrequest/345)",
+			Subject: "message @foo [#123](https://dev.azure.com/org/project/_workitems/edit/123) [!345](https://dev.azure.com/org/project/_git/repo/pullrequest/345)",
+			Body:    `work item [#456](https://dev.azure.com/org/project/_workitems/edit/456)`,
+			Notes: []*Note{
+				{
+					Body: `pull request [!33](https://dev.azure.com/org/project/_git/repo/pullrequest/33)`,
+				},
+			},
+		},
+		processor.ProcessCommit(
+			&Commit{
+				Header:  "message @foo #123 !345",
+				Subject: "message @foo #123 !345",
+				Body:    `work item #456`,
+				Notes: []*Note{
+					{
+						Body: `pull request !33`,
+					},
+				},
+			},
+		),
+	)
+}
+
+func TestGitHubProcessorMarkdown(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &Config{
+		Info: &Info{
+			RepositoryURL: "https://example.com",
+		},
+	}
+
+	processor := &GitHubProcessor{}
+
+	processor.Bootstrap(config)
+
+	assert.Equal(
+		&Commit{
+			Header:  "fix `@Input` and `#123` of [@foo](https://github.com/foo) [#123](https://example.com/issues/123)",
+			Subject: "fix `@Input` and `#123` of [@foo](https://github.com/foo) [#123](https://example.com/issues/123)",
+			Body: "already linked [#12](https://example.com/pull/12) and <https://example.com/#34>\n" +
+				"see https://example.com/issues/56#issuecomment-78 or mail foo@example.com\n" +
+				"```go\n" +
+				"// @bar #90\n" +
+				"```\n" +
+				"[@bar](https://github.com/bar) [#90](https://example.com/issues/90)",
+		},
+		processor.ProcessCommit(
+			&Commit{
+				Header:  "fix `@Input` and `#123` of @foo #123",
+				Subject: "fix `@Input` and `#123` of @foo #123",
+				Body: "already linked [#12](https://example.com/pull/12) and <https://example.com/#34>\n" +
+					"see https://example.com/issues/56#issuecomment-78 or mail foo@example.com\n" +
+					"```go\n" +
+					"// @bar #90\n" +
+					"```\n" +
+					"@bar #90",
+			},
+		),
+	)
+}
+
+func TestGitHubProcessorCrossRepository(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &Config{
+		Info: &Info{
+			RepositoryURL: "https://github.com/owner/repo",
+		},
+	}
+
+	processor := &GitHubProcessor{}
+
+	processor.Bootstrap(config)
+
+	assert.Equal(
+		&Commit{
+			Subject: "fix of [other/repo#12](https://github.com/other/repo/issues/12) and [#34](https://github.com/owner/repo/issues/34)",
+			Refs: []*Ref{
+				{Action: "Closes", Ref: "12", Source: "other/repo", URL: "https://github.com/other/repo/issues/12"},
+				{Action: "Closes", Ref: "34", URL: "https://github.com/owner/repo/issues/34"},
+				{Action: "Closes", Ref: "56", Source: "repo"},
+				{Action: "Closes", Ref: "78", URL: "https://example.com/78"},
+			},
+		},
+		processor.ProcessCommit(
+			&Commit{
+				Subject: "fix of other/repo#12 and #34",
+				Refs: []*Ref{
+					{Action: "Closes", Ref: "12", Source: "other/repo"},
+					{Action: "Closes", Ref: "34"},
+					{Action: "Closes", Ref: "56", Source: "repo"},
+					{Action: "Closes", Ref: "78", URL: "https://example.com/78"},
+				},
+			},
+		),
+	)
+}
+
+func TestGitLabProcessorCrossProject(t *testing.T) {
+	assert := assert.New(t)
+
+	config := &Config{
+		Info: &Info{
+			RepositoryURL: "https://gitlab.com/group/project",
+		},
+	}
+
+	processor := &GitLabProcessor{}
+
+	processor.Bootstrap(config)
+
+	assert.Equal(
+		&Commit{
+			Subject: "see [group/sub/other#12](https://gitlab.com/group/sub/other/issues/12) and [group/other!34](https://gitlab.com/group/other/merge_requests/34)",
+			Refs: []*Ref{
+				{Action: "Closes", Ref: "12", Source: "group/sub/other", URL: "https://gitlab.com/group/sub/other/issues/12"},
+				{Ref: "34", Source: "group/other", Kind: RefKindPullRequest, URL: "https://gitlab.com/group/other/merge_requests/34"},
+				{Ref: "56", Kind: RefKindPullRequest, URL: "https://gitlab.com/group/project/merge_requests/56"},
+				{Action: "Closes", Ref: "PROJ-1", Kind: RefKindExternal, URL: "https://jira.example.com/browse/PROJ-1"},
+			},
+		},
+		processor.ProcessCommit(
+			&Commit{
+				Subject: "see group/sub/other#12 and group/other!34",
+				Refs: []*Ref{
+					{Action: "Closes", Ref: "12", Source: "group/sub/other"},
+					{Ref: "34", Source: "group/other", Kind: RefKindPullRequest},
+					{Ref: "56", Kind: RefKindPullRequest},
+					{Action: "Closes", Ref: "PROJ-1", Kind: RefKindExternal, URL: "https://jira.example.com/browse/PROJ-1"},
+				},
+			},
+		),
+	)
+}
@@ -0,0 +1,83 @@
+package chglog
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	gitcmd "github.com/tsuyoshiwada/go-gitcmd"
+)
+
+type shallowChecker struct {
+	client     gitcmd.Client
+	boundaries map[string]bool
+}
+
+func newShallowChecker(client gitcmd.Client) *shallowChecker {
+	return &shallowChecker{
+		client: client,
+	}
+}
+
+// IsShallow reports whether the repository is a shallow clone
+func (c *shallowChecker) IsShallow() (bool, error) {
+	out, err := c.client.Exec("rev-parse", "--is-shallow-repository")
+	if err != nil {
+		return false, err
+	}
+	return strings.TrimSpace(out) == "true", nil
+}
+
+// FetchTags fetches all tags from the default remote
+func (c *shallowChecker) FetchTags() error {
+	_, err := c.client.Exec("fetch", "--tags", "--force")
+	return err
+}
+
+// Unshallow converts a shallow clone into a complete one, tags included
+func (c *shallowChecker) Unshallow() error {
+	_, err := c.client.Exec("fetch", "--unshallow", "--tags", "--force")
+	c.boundaries = nil
+	return err
+}
+
+// Load reads the boundary commits of a shallow clone, history beyond them is missing
+func (c *shallowChecker) Load() error {
+	out, err := c.client.Exec("rev-parse", "--git-path", "shallow")
+	if err != nil {
+		return err
+	}
+
+	c.boundaries = map[string]bool{}
+
+	bytes, err := os.ReadFile(filepath.Clean(strings.TrimSpace(out)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, line := range strings.Split(string(bytes), "\n") {
+		if hash := strings.TrimSpace(line); hash != "" {
+			c.boundaries[hash] = true
+		}
+	}
+
+	return nil
+}
+
+// IsTruncated reports whether commits reach a shallow boundary, i.e. older commits of the range are missing
+func (c *shallowChecker) IsTruncated(commits []*Commit) bool {
+	if len(c.boundaries) == 0 {
+		return false
+	}
+
+	for _, commit := range commits {
+		if commit != nil && commit.Hash != nil && c.boundaries[commit.Hash.Long] {
+			return true
+		}
+	}
+
+	return false
+}
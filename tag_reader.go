@@ -15,14 +15,21 @@ type tagReader struct {
 	client    gitcmd.Client
 	separator string
 	reFilter  *regexp.Regexp
+	reName    *regexp.Regexp
 	sortBy    string
 }
 
-func newTagReader(client gitcmd.Client, filterPattern string, sort string) *tagReader {
+func newTagReader(client gitcmd.Client, filterPattern string, namePattern string, sort string) *tagReader {
+	var reName *regexp.Regexp
+	if namePattern != "" {
+		reName = regexp.MustCompile(namePattern)
+	}
+
 	return &tagReader{
 		client:    client,
 		separator: "@@__CHGLOG__@@",
 		reFilter:  regexp.MustCompile(filterPattern),
+		reName:    reName,
 		sortBy:    sort,
 	}
 }
@@ -67,10 +74,14 @@ func (r *tagReader) ReadAll() ([]*Tag, error) {
 			}
 		}
 
+		displayName, version := r.parseName(name)
+
 		tags = append(tags, &Tag{
-			Name:    name,
-			Subject: subject,
-			Date:    date,
+			Name:        name,
+			DisplayName: displayName,
+			Version:     version,
+			Subject:     subject,
+			Date:        date,
 		})
 	}
 
@@ -81,7 +92,7 @@ func (r *tagReader) ReadAll() ([]*Tag, error) {
 		r.filterSemVerTags(&tags)
 		r.sortTagsBySemver(tags)
 	}
-	r.assignPreviousAndNextTag(tags)
+	assignPreviousAndNextTag(tags)
 
 	return tags, nil
 }
@@ -91,7 +102,7 @@ func (*tagReader) filterSemVerTags(tags *[]*Tag) {
 	for i, t := range *tags {
 		// remove leading v, since its so
 		// common.
-		name := strings.TrimPrefix(t.Name, "v")
+		name := strings.TrimPrefix(t.Version, "v")
 
 		// attempt semver parse, if not successful
 		// remove it from tags slice.
@@ -105,6 +116,43 @@ func (*tagReader) parseRefname(input string) string {
 	return strings.Replace(input, "refs/tags/", "", 1)
 }
 
+// parseName applies the named capture groups (`DisplayName`, `Version`) of the tag name pattern.
+// If the pattern is not specified or does not match, the raw tag name is used for both.
+func (r *tagReader) parseName(name string) (string, string) {
+	displayName := name
+	version := name
+
+	if r.reName == nil {
+		return displayName, version
+	}
+
+	res := r.reName.FindStringSubmatch(name)
+	if len(res) == 0 {
+		return displayName, version
+	}
+
+	hasDisplayName := false
+	for i, group := range r.reName.SubexpNames() {
+		if res[i] == "" {
+			continue
+		}
+		switch group {
+		case "DisplayName":
+			displayName = res[i]
+			hasDisplayName = true
+		case "Version":
+			version = res[i]
+		}
+	}
+
+	// Without an explicit display name, the extracted version is the clean name
+	if !hasDisplayName {
+		displayName = version
+	}
+
+	return displayName, version
+}
+
 func (*tagReader) parseSubject(input string) string {
 	return strings.TrimSpace(input)
 }
@@ -113,7 +161,7 @@ func (*tagReader) parseDate(input string) (time.Time, error) {
 	return time.Parse("Mon Jan 2 15:04:05 2006 -0700", input)
 }
 
-func (*tagReader) assignPreviousAndNextTag(tags []*Tag) {
+func assignPreviousAndNextTag(tags []*Tag) {
 	total := len(tags)
 
 	for i, tag := range tags {
@@ -124,17 +172,21 @@ func (*tagReader) assignPreviousAndNextTag(tags []*Tag) {
 
 		if i > 0 {
 			next = &RelateTag{
-				Name:    tags[i-1].Name,
-				Subject: tags[i-1].Subject,
-				Date:    tags[i-1].Date,
+				Name:        tags[i-1].Name,
+				DisplayName: tags[i-1].DisplayName,
+				Version:     tags[i-1].Version,
+				Subject:     tags[i-1].Subject,
+				Date:        tags[i-1].Date,
 			}
 		}
 
 		if i+1 < total {
 			prev = &RelateTag{
-				Name:    tags[i+1].Name,
-				Subject: tags[i+1].Subject,
-				Date:    tags[i+1].Date,
+				Name:        tags[i+1].Name,
+				DisplayName: tags[i+1].DisplayName,
+				Version:     tags[i+1].Version,
+				Subject:     tags[i+1].Subject,
+				Date:        tags[i+1].Date,
 			}
 		}
 
@@ -151,8 +203,8 @@ func (*tagReader) sortTags(tags []*Tag) {
 
 func (*tagReader) sortTagsBySemver(tags []*Tag) {
 	sort.Slice(tags, func(i, j int) bool {
-		semver1 := strings.TrimPrefix(tags[i].Name, "v")
-		semver2 := strings.TrimPrefix(tags[j].Name, "v")
+		semver1 := strings.TrimPrefix(tags[i].Version, "v")
+		semver2 := strings.TrimPrefix(tags[j].Version, "v")
 		v1 := semver.New(semver1)
 		v2 := semver.New(semver2)
 		return v2.LessThan(*v1)
@@ -29,90 +29,122 @@ func TestTagReader(t *testing.T) {
 		},
 	}
 
-	actual, err := newTagReader(client, "", "date").ReadAll()
+	actual, err := newTagReader(client, "", "", "date").ReadAll()
 	assert.Nil(err)
 
 	assert.Equal(
 		[]*Tag{
 			{
-				Name:    "hoge_fuga",
-				Subject: "Invalid semver tag name",
-				Date:    time.Date(2018, 3, 12, 12, 30, 10, 0, time.UTC),
-				Next:    nil,
+				Name:        "hoge_fuga",
+				DisplayName: "hoge_fuga",
+				Version:     "hoge_fuga",
+				Subject:     "Invalid semver tag name",
+				Date:        time.Date(2018, 3, 12, 12, 30, 10, 0, time.UTC),
+				Next:        nil,
 				Previous: &RelateTag{
-					Name:    "5.0.0-rc.0",
-					Subject: "Release 5.0.0-rc.0",
-					Date:    time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
+					Name:        "5.0.0-rc.0",
+					DisplayName: "5.0.0-rc.0",
+					Version:     "5.0.0-rc.0",
+					Subject:     "Release 5.0.0-rc.0",
+					Date:        time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "5.0.0-rc.0",
-				Subject: "Release 5.0.0-rc.0",
-				Date:    time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
+				Name:        "5.0.0-rc.0",
+				DisplayName: "5.0.0-rc.0",
+				Version:     "5.0.0-rc.0",
+				Subject:     "Release 5.0.0-rc.0",
+				Date:        time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "hoge_fuga",
-					Subject: "Invalid semver tag name",
-					Date:    time.Date(2018, 3, 12, 12, 30, 10, 0, time.UTC),
+					Name:        "hoge_fuga",
+					DisplayName: "hoge_fuga",
+					Version:     "hoge_fuga",
+					Subject:     "Invalid semver tag name",
+					Date:        time.Date(2018, 3, 12, 12, 30, 10, 0, time.UTC),
 				},
 				Previous: &RelateTag{
-					Name:    "v2.0.4-beta.2",
-					Subject: "Release v2.0.4-beta.2",
-					Date:    time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
+					Name:        "v2.0.4-beta.2",
+					DisplayName: "v2.0.4-beta.2",
+					Version:     "v2.0.4-beta.2",
+					Subject:     "Release v2.0.4-beta.2",
+					Date:        time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "v2.0.4-beta.2",
-				Subject: "Release v2.0.4-beta.2",
-				Date:    time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
+				Name:        "v2.0.4-beta.2",
+				DisplayName: "v2.0.4-beta.2",
+				Version:     "v2.0.4-beta.2",
+				Subject:     "Release v2.0.4-beta.2",
+				Date:        time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "5.0.0-rc.0",
-					Subject: "Release 5.0.0-rc.0",
-					Date:    time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
+					Name:        "5.0.0-rc.0",
+					DisplayName: "5.0.0-rc.0",
+					Version:     "5.0.0-rc.0",
+					Subject:     "Release 5.0.0-rc.0",
+					Date:        time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
 				},
 				Previous: &RelateTag{
-					Name:    "4.4.4",
-					Subject: "Release 4.4.4",
-					Date:    time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
+					Name:        "4.4.4",
+					DisplayName: "4.4.4",
+					Version:     "4.4.4",
+					Subject:     "Release 4.4.4",
+					Date:        time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "4.4.4",
-				Subject: "Release 4.4.4",
-				Date:    time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
+				Name:        "4.4.4",
+				DisplayName: "4.4.4",
+				Version:     "4.4.4",
+				Subject:     "Release 4.4.4",
+				Date:        time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "v2.0.4-beta.2",
-					Subject: "Release v2.0.4-beta.2",
-					Date:    time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
+					Name:        "v2.0.4-beta.2",
+					DisplayName: "v2.0.4-beta.2",
+					Version:     "v2.0.4-beta.2",
+					Subject:     "Release v2.0.4-beta.2",
+					Date:        time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
 				},
 				Previous: &RelateTag{
-					Name:    "4.4.3",
-					Subject: "This is tag subject",
-					Date:    time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
+					Name:        "4.4.3",
+					DisplayName: "4.4.3",
+					Version:     "4.4.3",
+					Subject:     "This is tag subject",
+					Date:        time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "4.4.3",
-				Subject: "This is tag subject",
-				Date:    time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
+				Name:        "4.4.3",
+				DisplayName: "4.4.3",
+				Version:     "4.4.3",
+				Subject:     "This is tag subject",
+				Date:        time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "4.4.4",
-					Subject: "Release 4.4.4",
-					Date:    time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
+					Name:        "4.4.4",
+					DisplayName: "4.4.4",
+					Version:     "4.4.4",
+					Subject:     "Release 4.4.4",
+					Date:        time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
 				},
 				Previous: &RelateTag{
-					Name:    "v2.0.4-beta.1",
-					Subject: "Release v2.0.4-beta.1",
-					Date:    time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
+					Name:        "v2.0.4-beta.1",
+					DisplayName: "v2.0.4-beta.1",
+					Version:     "v2.0.4-beta.1",
+					Subject:     "Release v2.0.4-beta.1",
+					Date:        time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "v2.0.4-beta.1",
-				Subject: "Release v2.0.4-beta.1",
-				Date:    time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
+				Name:        "v2.0.4-beta.1",
+				DisplayName: "v2.0.4-beta.1",
+				Version:     "v2.0.4-beta.1",
+				Subject:     "Release v2.0.4-beta.1",
+				Date:        time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "4.4.3",
-					Subject: "This is tag subject",
-					Date:    time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
+					Name:        "4.4.3",
+					DisplayName: "4.4.3",
+					Version:     "4.4.3",
+					Subject:     "This is tag subject",
+					Date:        time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
 				},
 				Previous: nil,
 			},
@@ -120,75 +152,101 @@ func TestTagReader(t *testing.T) {
 		actual,
 	)
 
-	actual, err = newTagReader(client, "", "semver").ReadAll()
+	actual, err = newTagReader(client, "", "", "semver").ReadAll()
 	assert.Nil(err)
 
 	assert.Equal(
 		[]*Tag{
 			{
-				Name:    "5.0.0-rc.0",
-				Subject: "Release 5.0.0-rc.0",
-				Date:    time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
-				Next:    nil,
+				Name:        "5.0.0-rc.0",
+				DisplayName: "5.0.0-rc.0",
+				Version:     "5.0.0-rc.0",
+				Subject:     "Release 5.0.0-rc.0",
+				Date:        time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
+				Next:        nil,
 				Previous: &RelateTag{
-					Name:    "4.4.4",
-					Subject: "Release 4.4.4",
-					Date:    time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
+					Name:        "4.4.4",
+					DisplayName: "4.4.4",
+					Version:     "4.4.4",
+					Subject:     "Release 4.4.4",
+					Date:        time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "4.4.4",
-				Subject: "Release 4.4.4",
-				Date:    time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
+				Name:        "4.4.4",
+				DisplayName: "4.4.4",
+				Version:     "4.4.4",
+				Subject:     "Release 4.4.4",
+				Date:        time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "5.0.0-rc.0",
-					Subject: "Release 5.0.0-rc.0",
-					Date:    time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
+					Name:        "5.0.0-rc.0",
+					DisplayName: "5.0.0-rc.0",
+					Version:     "5.0.0-rc.0",
+					Subject:     "Release 5.0.0-rc.0",
+					Date:        time.Date(2018, 2, 3, 12, 30, 10, 0, time.UTC),
 				},
 				Previous: &RelateTag{
-					Name:    "4.4.3",
-					Subject: "This is tag subject",
-					Date:    time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
+					Name:        "4.4.3",
+					DisplayName: "4.4.3",
+					Version:     "4.4.3",
+					Subject:     "This is tag subject",
+					Date:        time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "4.4.3",
-				Subject: "This is tag subject",
-				Date:    time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
+				Name:        "4.4.3",
+				DisplayName: "4.4.3",
+				Version:     "4.4.3",
+				Subject:     "This is tag subject",
+				Date:        time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "4.4.4",
-					Subject: "Release 4.4.4",
-					Date:    time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
+					Name:        "4.4.4",
+					DisplayName: "4.4.4",
+					Version:     "4.4.4",
+					Subject:     "Release 4.4.4",
+					Date:        time.Date(2018, 2, 2, 10, 0, 40, 0, time.UTC),
 				},
 				Previous: &RelateTag{
-					Name:    "v2.0.4-beta.2",
-					Subject: "Release v2.0.4-beta.2",
-					Date:    time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
+					Name:        "v2.0.4-beta.2",
+					DisplayName: "v2.0.4-beta.2",
+					Version:     "v2.0.4-beta.2",
+					Subject:     "Release v2.0.4-beta.2",
+					Date:        time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "v2.0.4-beta.2",
-				Subject: "Release v2.0.4-beta.2",
-				Date:    time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
+				Name:        "v2.0.4-beta.2",
+				DisplayName: "v2.0.4-beta.2",
+				Version:     "v2.0.4-beta.2",
+				Subject:     "Release v2.0.4-beta.2",
+				Date:        time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "4.4.3",
-					Subject: "This is tag subject",
-					Date:    time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
+					Name:        "4.4.3",
+					DisplayName: "4.4.3",
+					Version:     "4.4.3",
+					Subject:     "This is tag subject",
+					Date:        time.Date(2018, 2, 2, 0, 0, 0, 0, time.UTC),
 				},
 				Previous: &RelateTag{
-					Name:    "v2.0.4-beta.1",
-					Subject: "Release v2.0.4-beta.1",
-					Date:    time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
+					Name:        "v2.0.4-beta.1",
+					DisplayName: "v2.0.4-beta.1",
+					Version:     "v2.0.4-beta.1",
+					Subject:     "Release v2.0.4-beta.1",
+					Date:        time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "v2.0.4-beta.1",
-				Subject: "Release v2.0.4-beta.1",
-				Date:    time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
+				Name:        "v2.0.4-beta.1",
+				DisplayName: "v2.0.4-beta.1",
+				Version:     "v2.0.4-beta.1",
+				Subject:     "Release v2.0.4-beta.1",
+				Date:        time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "v2.0.4-beta.2",
-					Subject: "Release v2.0.4-beta.2",
-					Date:    time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
+					Name:        "v2.0.4-beta.2",
+					DisplayName: "v2.0.4-beta.2",
+					Version:     "v2.0.4-beta.2",
+					Subject:     "Release v2.0.4-beta.2",
+					Date:        time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
 				},
 				Previous: nil,
 			},
@@ -196,29 +254,37 @@ func TestTagReader(t *testing.T) {
 		actual,
 	)
 
-	actualFiltered, errFiltered := newTagReader(client, "^v", "date").ReadAll()
+	actualFiltered, errFiltered := newTagReader(client, "^v", "", "date").ReadAll()
 	assert.Nil(errFiltered)
 	assert.Equal(
 		[]*Tag{
 			{
-				Name:    "v2.0.4-beta.2",
-				Subject: "Release v2.0.4-beta.2",
-				Date:    time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
-				Next:    nil,
+				Name:        "v2.0.4-beta.2",
+				DisplayName: "v2.0.4-beta.2",
+				Version:     "v2.0.4-beta.2",
+				Subject:     "Release v2.0.4-beta.2",
+				Date:        time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
+				Next:        nil,
 				Previous: &RelateTag{
-					Name:    "v2.0.4-beta.1",
-					Subject: "Release v2.0.4-beta.1",
-					Date:    time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
+					Name:        "v2.0.4-beta.1",
+					DisplayName: "v2.0.4-beta.1",
+					Version:     "v2.0.4-beta.1",
+					Subject:     "Release v2.0.4-beta.1",
+					Date:        time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
 				},
 			},
 			{
-				Name:    "v2.0.4-beta.1",
-				Subject: "Release v2.0.4-beta.1",
-				Date:    time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
+				Name:        "v2.0.4-beta.1",
+				DisplayName: "v2.0.4-beta.1",
+				Version:     "v2.0.4-beta.1",
+				Subject:     "Release v2.0.4-beta.1",
+				Date:        time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC),
 				Next: &RelateTag{
-					Name:    "v2.0.4-beta.2",
-					Subject: "Release v2.0.4-beta.2",
-					Date:    time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
+					Name:        "v2.0.4-beta.2",
+					DisplayName: "v2.0.4-beta.2",
+					Version:     "v2.0.4-beta.2",
+					Subject:     "Release v2.0.4-beta.2",
+					Date:        time.Date(2018, 2, 3, 12, 15, 0, 0, time.UTC),
 				},
 				Previous: nil,
 			},
@@ -226,3 +292,42 @@ func TestTagReader(t *testing.T) {
 		actualFiltered,
 	)
 }
+
+func TestTagReaderWithNamePattern(t *testing.T) {
+	assert := assert.New(t)
+	client := &mockClient{
+		ReturnExec: func(subcmd string, args ...string) (string, error) {
+			if subcmd != "for-each-ref" {
+				return "", errors.New("")
+			}
+			return strings.Join([]string{
+				"",
+				"refs/tags/release-2025.04.1-prod@@__CHGLOG__@@Release@@__CHGLOG__@@Tue Apr 1 00:00:00 2025 +0000@@__CHGLOG__@@",
+				"refs/tags/release-2025.03.2-prod@@__CHGLOG__@@Release@@__CHGLOG__@@Sat Mar 1 00:00:00 2025 +0000@@__CHGLOG__@@",
+				"refs/tags/hotfix@@__CHGLOG__@@Hotfix@@__CHGLOG__@@Sat Feb 1 00:00:00 2025 +0000@@__CHGLOG__@@",
+			}, "\n"), nil
+		},
+	}
+
+	actual, err := newTagReader(client, "", `^release-(?P<Version>[\d.]+)-prod$`, "date").ReadAll()
+	assert.Nil(err)
+	assert.Len(actual, 3)
+
+	assert.Equal("release-2025.04.1-prod", actual[0].Name)
+	assert.Equal("2025.04.1", actual[0].DisplayName)
+	assert.Equal("2025.04.1", actual[0].Version)
+	assert.Equal("release-2025.03.2-prod", actual[0].Previous.Name)
+	assert.Equal("2025.03.2", actual[0].Previous.DisplayName)
+
+	// not matched
+	assert.Equal("hotfix", actual[2].Name)
+	assert.Equal("hotfix", actual[2].DisplayName)
+	assert.Equal("hotfix", actual[2].Version)
+
+	// display name
+	actual, err = newTagReader(client, "^release", `^release-(?P<Version>[\d.]+)-(?P<DisplayName>prod)$`, "date").ReadAll()
+	assert.Nil(err)
+	assert.Len(actual, 2)
+	assert.Equal("prod", actual[0].DisplayName)
+	assert.Equal("2025.04.1", actual[0].Version)
+}
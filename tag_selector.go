@@ -34,11 +34,38 @@ func (s *tagSelector) Select(tags []*Tag, query string) ([]*Tag, string, error)
 	return nil, "", errFailedQueryParse
 }
 
+// Unmatched returns the tags of the query that match none of the tags
+func (s *tagSelector) Unmatched(tags []*Tag, query string) []string {
+	unmatched := []string{}
+
+	for _, token := range strings.Split(query, "..") {
+		if token != "" && !s.exists(tags, token) {
+			unmatched = append(unmatched, token)
+		}
+	}
+
+	return unmatched
+}
+
+func (s *tagSelector) exists(tags []*Tag, token string) bool {
+	for _, tag := range tags {
+		if s.match(tag, token) {
+			return true
+		}
+	}
+	return false
+}
+
+// match reports whether the tag is referred to by its name or its version (e.g. synthetic CalVer tags)
+func (*tagSelector) match(tag *Tag, token string) bool {
+	return tag.Name == token || (tag.Version != "" && tag.Version == token)
+}
+
 func (s *tagSelector) selectSingleTag(tags []*Tag, token string) ([]*Tag, string, error) {
 	var from string
 
 	for i, tag := range tags {
-		if tag.Name == token {
+		if s.match(tag, token) {
 			if i+1 < len(tags) {
 				from = tags[i+1].Name
 			}
@@ -49,7 +76,7 @@ func (s *tagSelector) selectSingleTag(tags []*Tag, token string) ([]*Tag, string
 	return nil, "", nil
 }
 
-func (*tagSelector) selectBeforeTags(tags []*Tag, token string) ([]*Tag, string, error) {
+func (s *tagSelector) selectBeforeTags(tags []*Tag, token string) ([]*Tag, string, error) {
 	var (
 		res    []*Tag
 		from   string
@@ -57,7 +84,7 @@ func (*tagSelector) selectBeforeTags(tags []*Tag, token string) ([]*Tag, string,
 	)
 
 	for i, tag := range tags {
-		if tag.Name == token {
+		if s.match(tag, token) {
 			enable = true
 		}
 
@@ -77,7 +104,7 @@ func (*tagSelector) selectBeforeTags(tags []*Tag, token string) ([]*Tag, string,
 	return res, from, nil
 }
 
-func (*tagSelector) selectAfterTags(tags []*Tag, token string) ([]*Tag, string, error) {
+func (s *tagSelector) selectAfterTags(tags []*Tag, token string) ([]*Tag, string, error) {
 	// NOTE(clok): the res slice can range in size based on the token passed in.
 	var ( //nolint:prealloc
 		res  []*Tag
@@ -91,7 +118,7 @@ func (*tagSelector) selectAfterTags(tags []*Tag, token string) ([]*Tag, string,
 			from = tags[i+1].Name
 		}
 
-		if tag.Name == token {
+		if s.match(tag, token) {
 			break
 		}
 	}
@@ -111,7 +138,7 @@ func (s *tagSelector) selectRangeTags(tags []*Tag, old string, new string) ([]*T
 	)
 
 	for i, tag := range tags {
-		if tag.Name == new {
+		if s.match(tag, new) {
 			enable = true
 		}
 
@@ -123,7 +150,7 @@ func (s *tagSelector) selectRangeTags(tags []*Tag, old string, new string) ([]*T
 			res = append(res, tag)
 		}
 
-		if tag.Name == old {
+		if s.match(tag, old) {
 			enable = false
 		}
 	}
@@ -99,3 +99,41 @@ func TestTagSelector(t *testing.T) {
 		assert.Equal(expected[len(expected)-1], from)
 	}
 }
+
+func TestTagSelectorByVersion(t *testing.T) {
+	assert := assert.New(t)
+	selector := newTagSelector()
+
+	fixtures := []*Tag{
+		{Name: "c3c3c3", Version: "2018-W06"},
+		{Name: "b2b2b2", Version: "2018-W05"},
+		{Name: "a1a1a1", Version: "2018-W04"},
+	}
+
+	list, from, err := selector.Select(fixtures, "2018-W05")
+	assert.Nil(err)
+	assert.Equal([]*Tag{fixtures[1]}, list)
+	assert.Equal("a1a1a1", from)
+
+	list, from, err = selector.Select(fixtures, "2018-W04..2018-W05")
+	assert.Nil(err)
+	assert.Equal([]*Tag{fixtures[1], fixtures[2]}, list)
+	assert.Equal("", from)
+}
+
+func TestTagSelectorUnmatched(t *testing.T) {
+	assert := assert.New(t)
+	selector := newTagSelector()
+
+	fixtures := []*Tag{
+		{Name: "2.0.0"},
+		{Name: "c3c3c3", Version: "2018-W06"},
+		{Name: "1.0.0"},
+	}
+
+	assert.Equal([]string{}, selector.Unmatched(fixtures, "1.0.0..2.0.0"))
+	assert.Equal([]string{}, selector.Unmatched(fixtures, "2018-W06.."))
+	assert.Equal([]string{"0.1.0"}, selector.Unmatched(fixtures, "0.1.0..2.0.0"))
+	assert.Equal([]string{"3.0.0"}, selector.Unmatched(fixtures, "..3.0.0"))
+	assert.Equal([]string{"0.1.0", "3.0.0"}, selector.Unmatched(fixtures, "0.1.0..3.0.0"))
+}
@@ -3,6 +3,7 @@ package chglog
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -15,17 +16,13 @@ func dotGet(target interface{}, prop string) (interface{}, bool) {
 	}
 
 	for _, key := range path {
-		var value reflect.Value
-
-		if reflect.TypeOf(target).Kind() == reflect.Ptr {
-			value = reflect.ValueOf(target).Elem()
-		} else {
-			value = reflect.ValueOf(target)
+		value := reflect.ValueOf(target)
+		if value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+			value = value.Elem()
 		}
 
-		//nolint:staticcheck
-		field := value.FieldByName(strings.Title(key))
-		if !field.IsValid() {
+		field, ok := dotGetField(value, key)
+		if !ok {
 			return nil, false
 		}
 
@@ -35,6 +32,35 @@ func dotGet(target interface{}, prop string) (interface{}, bool) {
 	return target, true
 }
 
+// dotGetField returns a field of a struct, a value of a map with string keys or an element of a slice.
+// Field names and map keys are case-insensitive.
+func dotGetField(value reflect.Value, key string) (reflect.Value, bool) {
+	switch value.Kind() {
+	case reflect.Struct:
+		//nolint:staticcheck
+		field := value.FieldByName(strings.Title(key))
+		return field, field.IsValid()
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return reflect.Value{}, false
+		}
+		if v := value.MapIndex(reflect.ValueOf(key).Convert(value.Type().Key())); v.IsValid() {
+			return v, true
+		}
+		for _, k := range value.MapKeys() {
+			if strings.EqualFold(k.String(), key) {
+				return value.MapIndex(k), true
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if i, err := strconv.Atoi(key); err == nil && i >= 0 && i < value.Len() {
+			return value.Index(i), true
+		}
+	}
+
+	return reflect.Value{}, false
+}
+
 // TODO: dotSet ...
 
 func assignDynamicValues(target interface{}, attrs []string, values []string) {
@@ -50,7 +76,7 @@ func assignDynamicValues(target interface{}, attrs []string, values []string) {
 
 func compare(a interface{}, operator string, b interface{}) (bool, error) {
 	at := reflect.TypeOf(a).String()
-	bt := reflect.TypeOf(a).String()
+	bt := reflect.TypeOf(b).String()
 	if at != bt {
 		return false, fmt.Errorf("\"%s\" and \"%s\" can not be compared", at, bt)
 	}
@@ -68,6 +94,10 @@ func compare(a interface{}, operator string, b interface{}) (bool, error) {
 		aa := a.(time.Time)
 		bb := b.(time.Time)
 		return compareTime(aa, operator, bb), nil
+	case "[]string":
+		aa := a.([]string)
+		bb := b.([]string)
+		return compareStrings(aa, operator, bb), nil
 	}
 
 	return false, nil
@@ -84,6 +114,16 @@ func compareString(a string, operator string, b string) bool {
 	}
 }
 
+// compareStrings compares lists by their first different value, a prefix of a list is lower than it
+func compareStrings(a []string, operator string, b []string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return compareString(a[i], operator, b[i])
+		}
+	}
+	return compareInt(len(a), operator, len(b))
+}
+
 func compareInt(a int, operator string, b int) bool {
 	switch operator {
 	case "<":
@@ -113,3 +153,28 @@ func convNewline(str, nlcode string) string {
 		"\n", nlcode,
 	).Replace(str)
 }
+
+// levenshtein returns the edit distance of two strings
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
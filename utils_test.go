@@ -100,6 +100,10 @@ func TestCompare(t *testing.T) {
 		{"a", ">", "b", false},
 		{time.Unix(1518018017, 0), "<", time.Unix(1518018043, 0), true},
 		{time.Unix(1518018017, 0), ">", time.Unix(1518018043, 0), false},
+		{[]string{"a", "c"}, "<", []string{"b"}, true},
+		{[]string{"a", "c"}, ">", []string{"a", "b"}, true},
+		{[]string{"a"}, "<", []string{"a", "b"}, true},
+		{[]string{"a"}, ">", []string{"a", "b"}, false},
 	}
 
 	for _, sa := range table {
@@ -107,4 +111,37 @@ func TestCompare(t *testing.T) {
 		assert.Nil(err)
 		assert.Equal(sa.expected, actual)
 	}
+
+	_, err := compare([]string{"a"}, "<", "b")
+	assert.EqualError(err, "\"[]string\" and \"string\" can not be compared")
+}
+
+func TestDotGetMapsAndSlices(t *testing.T) {
+	assert := assert.New(t)
+
+	commit := &Commit{
+		JiraIssues: []*JiraIssue{
+			{Key: "PROJ-1", Fields: map[string]interface{}{"Epic": "PROJ-5", "FixVersions": []string{"1.2.0"}}},
+		},
+	}
+
+	val, ok := dotGet(commit, "JiraIssues.0.Fields.Epic")
+	assert.True(ok)
+	assert.Equal("PROJ-5", val)
+
+	val, ok = dotGet(commit, "jiraIssues.0.fields.fixVersions")
+	assert.True(ok)
+	assert.Equal([]string{"1.2.0"}, val)
+
+	// nil pointer
+	_, ok = dotGet(commit, "JiraIssue.Fields.Epic")
+	assert.False(ok)
+
+	// out of range
+	_, ok = dotGet(commit, "JiraIssues.1.Key")
+	assert.False(ok)
+
+	// missing key
+	_, ok = dotGet(commit, "JiraIssues.0.Fields.Sprint")
+	assert.False(ok)
 }
@@ -0,0 +1,78 @@
+package chglog
+
+import (
+	"regexp"
+)
+
+var reVersionSeries = regexp.MustCompile(`^[vV]?(\d+)(?:\.(\d+))?`)
+
+type versionGrouper struct {
+	groupBy string
+}
+
+func newVersionGrouper(groupBy string) *versionGrouper {
+	return &versionGrouper{
+		groupBy: groupBy,
+	}
+}
+
+// Group buckets versions into `VersionGroup` by their major (or major.minor) series.
+// Versions are expected in the order they are rendered (newest first), groups keep that order.
+// Tags whose `Version` does not start with a version number are not assigned to any group.
+func (g *versionGrouper) Group(versions []*Version) []*VersionGroup {
+	if g.groupBy == "" {
+		return nil
+	}
+
+	groups := []*VersionGroup{}
+
+	for _, version := range versions {
+		title, ok := g.seriesTitle(version.Tag)
+		if !ok {
+			continue
+		}
+
+		var group *VersionGroup
+		for _, vg := range groups {
+			if vg.Title == title {
+				group = vg
+				break
+			}
+		}
+
+		if group == nil {
+			group = &VersionGroup{
+				Title: title,
+			}
+			groups = append(groups, group)
+		}
+
+		group.Tags = append(group.Tags, version.Tag)
+		group.Versions = append(group.Versions, version)
+
+		if version.Tag.Date.After(group.Date) {
+			group.Date = version.Tag.Date
+		}
+	}
+
+	return groups
+}
+
+func (g *versionGrouper) seriesTitle(tag *Tag) (string, bool) {
+	res := reVersionSeries.FindStringSubmatch(tag.Version)
+	if len(res) == 0 {
+		return "", false
+	}
+
+	switch g.groupBy {
+	case "major":
+		return res[1] + ".x", true
+	case "minor":
+		if res[2] == "" {
+			return "", false
+		}
+		return res[1] + "." + res[2] + ".x", true
+	}
+
+	return "", false
+}
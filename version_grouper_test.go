@@ -0,0 +1,52 @@
+package chglog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionGrouper(t *testing.T) {
+	assert := assert.New(t)
+
+	tags := []*Tag{
+		{Name: "v2.1.0", Version: "v2.1.0", Date: time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "v2.0.1", Version: "v2.0.1", Date: time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "1.3.0", Version: "1.3.0", Date: time.Date(2018, 3, 15, 0, 0, 0, 0, time.UTC)},
+		{Name: "v2.0.0", Version: "v2.0.0", Date: time.Date(2018, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{Name: "nightly", Version: "nightly", Date: time.Date(2018, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{Name: "1.2.0", Version: "1.2.0", Date: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	versions := make([]*Version, len(tags))
+	for i, tag := range tags {
+		versions[i] = &Version{Tag: tag}
+	}
+
+	// disabled
+	assert.Nil(newVersionGrouper("").Group(versions))
+
+	// major
+	groups := newVersionGrouper("major").Group(versions)
+	assert.Len(groups, 2)
+
+	assert.Equal("2.x", groups[0].Title)
+	assert.Equal(time.Date(2018, 4, 1, 0, 0, 0, 0, time.UTC), groups[0].Date)
+	assert.Equal([]*Tag{tags[0], tags[1], tags[3]}, groups[0].Tags)
+	assert.Equal([]*Version{versions[0], versions[1], versions[3]}, groups[0].Versions)
+
+	assert.Equal("1.x", groups[1].Title)
+	assert.Equal(time.Date(2018, 3, 15, 0, 0, 0, 0, time.UTC), groups[1].Date)
+	assert.Equal([]*Tag{tags[2], tags[5]}, groups[1].Tags)
+
+	// minor
+	groups = newVersionGrouper("minor").Group(versions)
+	titles := []string{}
+	for _, g := range groups {
+		titles = append(titles, g.Title)
+	}
+	assert.Equal([]string{"2.1.x", "2.0.x", "1.3.x", "1.2.x"}, titles)
+	assert.Equal([]*Tag{tags[1], tags[3]}, groups[1].Tags)
+	assert.Equal(time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC), groups[1].Date)
+}